@@ -0,0 +1,255 @@
+package littledate
+
+import (
+	"strconv"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// AccessibleLabel describes a date range in words for screen readers, e.g.
+// as the aria-label of an element showing the output of FormatDateRange.
+// It covers the same days and times, but with full month and weekday names
+// and full years, and joins the ends of the range with a word of the locale
+// rather than the separator, so that "Jan 1 - 12" is not read out as "Jan
+// one dash twelve". The year is always written, except for times of today.
+//
+// Examples:
+// - January 1 to January 12, 2023
+// - 12 PM to 1 PM
+// - Sunday, January 1, 2023
+// - Quarter 1, 2023
+// - December 30, 2022 to January 2, 2023
+func AccessibleLabel(from, to time.Time, options DateRangeFormatOptions) string {
+	setDefaults(&options)
+	if options.Location != nil {
+		from = from.In(options.Location)
+		to = to.In(options.Location)
+	}
+
+	l := labelWriter{locale: localeOf(options), cal: calendarOf(options)}
+	if _, ok := l.cal.(japanese); ok {
+		// Abbreviated era years are read out like the full ones
+		l.cal = JapaneseEra
+	}
+	return protectNumbersString(l.label(from, to, options), options)
+}
+
+// AccessibleLabel describes a date range in words, see AccessibleLabel.
+func (f Formatter) AccessibleLabel(from, to time.Time) string {
+	return AccessibleLabel(from, to, f.Options)
+}
+
+// yearFirstLanguages lists the languages that write the year before the day
+var yearFirstLanguages = map[string]bool{
+	"hu": true,
+	"ja": true,
+	"ko": true,
+	"lt": true,
+	"mn": true,
+	"zh": true,
+}
+
+// isYearFirst reports whether the language of a tag writes the year before the day
+func isYearFirst(tag language.Tag) bool {
+	base, _ := tag.Base()
+	return yearFirstLanguages[base.String()]
+}
+
+// labelWriter writes the parts of an accessible label in a locale and calendar
+type labelWriter struct {
+	locale *localeData
+	cal    Calendar
+}
+
+// label follows the cases of appendRange, writing each part in full
+func (l labelWriter) label(from, to time.Time, options DateRangeFormatOptions) string {
+	cal := l.cal
+	fromYear, fromMonth, _ := cal.Date(from)
+	toYear, toMonth, _ := cal.Date(to)
+	sameYear := fromYear == toYear
+
+	var startTime, endTime bool
+	if options.IncludeTime {
+		startTime = !isSameMinute(startOfDay(from), from)
+		endTime = !isSameMinute(endOfDay(to), to)
+	}
+
+	// Same day, different times
+	// Example: January 1, 2023, 12 PM to 1 PM, or 12 PM to 1 PM today
+	if sameDay(from, to) && (startTime || endTime) {
+		times := l.join(l.time(from), l.time(to))
+		if sameDay(from, options.Today) {
+			return times
+		}
+		return l.date(from, true) + l.comma() + times
+	}
+
+	// Whole years, quarters and months
+	// Example: 2023, Quarter 1, 2023, January to February 2023
+	if isSameMinute(startOfCalendarYear(cal, from), from) && isSameMinute(endOfCalendarYear(cal, to), to) {
+		if sameYear {
+			return l.year(from)
+		}
+		return l.join(l.year(from), l.year(to))
+	}
+	if isCalendarQuarter(cal, from, to) {
+		return l.quarter(from)
+	}
+	if isSameMinute(startOfCalendarMonth(cal, from), from) && isSameMinute(endOfCalendarMonth(cal, to), to) {
+		if sameYear && fromMonth == toMonth {
+			return l.month(from, true)
+		}
+		return l.join(l.month(from, !sameYear), l.month(to, true))
+	}
+
+	// Full day
+	// Example: Sunday, January 1, 2023
+	if sameDay(from, to) {
+		return l.weekday(from)
+	}
+
+	// Range across days, with the year once if it is the same, on the end
+	// or, in locales writing the year first, on the start
+	// Example: January 1 to January 12, 2023, or 2023年1月1日から1月12日まで
+	start := l.date(from, !sameYear || l.locale.yearFirst)
+	if startTime {
+		start += l.comma() + l.time(from)
+	}
+	end := l.date(to, !sameYear || !l.locale.yearFirst)
+	if endTime {
+		end += l.comma() + l.time(to)
+	}
+	return l.join(start, end)
+}
+
+// join joins the two ends of a range with the word of the locale, e.g. "January 1 to January 12"
+func (l labelWriter) join(from, to string) string {
+	return l.locale.localize("accessible.range", -1, map[string]interface{}{
+		"From": from,
+		"To":   to,
+	})
+}
+
+// comma separates a date from its time, e.g. ", " in "January 1, 9 AM"
+func (l labelWriter) comma() string {
+	if _, ok := l.cal.(japanese); ok {
+		return " "
+	}
+	return l.locale.names.comma
+}
+
+// year writes the year of t, e.g. "2023" or "令和5年"
+func (l labelWriter) year(t time.Time) string {
+	if j, ok := l.cal.(japanese); ok {
+		return string(j.appendYear(nil, t, l.locale.digits))
+	}
+	year, _, _ := l.cal.Date(t)
+	return l.locale.localize("accessible.year", -1, map[string]interface{}{"Year": l.number(year)})
+}
+
+// quarter writes the quarter starting at t, e.g. "Quarter 1, 2023"
+func (l labelWriter) quarter(t time.Time) string {
+	quarter, _ := calendarQuarter(l.cal, t)
+	if j, ok := l.cal.(japanese); ok {
+		return string(j.appendYear(nil, t, l.locale.digits)) + "第" + l.number(quarter) + "四半期"
+	}
+	year, _, _ := l.cal.Date(t)
+	return l.locale.localize("accessible.quarter", -1, map[string]interface{}{
+		"Quarter": l.number(quarter),
+		"Year":    l.number(year),
+	})
+}
+
+// month writes the full name of the month of t, e.g. "January" or "January 2023"
+func (l labelWriter) month(t time.Time, withYear bool) string {
+	if j, ok := l.cal.(japanese); ok {
+		return string(j.appendMonth(j.appendYear(nil, t, l.locale.digits), int(t.Month()), l.locale.digits))
+	}
+	year, month, _ := l.cal.Date(t)
+	var yearText string
+	if withYear {
+		yearText = l.number(year)
+	}
+	return l.locale.localize("accessible.month", -1, map[string]interface{}{
+		"Month": l.cal.monthName(l.locale, year, month, true),
+		"Year":  yearText,
+	})
+}
+
+// date writes the day and full month name of t in the order of the locale,
+// e.g. "January 1", "January 1, 2023" or "1. Januar 2023". Japanese era dates
+// always have their year.
+func (l labelWriter) date(t time.Time, withYear bool) string {
+	year, month, day := l.cal.Date(t)
+	var yearText string
+	if withYear {
+		yearText = l.number(year)
+	}
+	switch c := l.cal.(type) {
+	case japanese:
+		return string(c.appendFullDate(nil, t, l.locale.digits))
+	case lunisolar:
+		if c.covers(t) {
+			s := l.locale.localize("calendar.chinese.date", -1, map[string]interface{}{
+				"Month": c.monthName(l.locale, year, month, true),
+				"Day":   localizeName(l.locale.localizer, "calendar.chinese.day."+strconv.Itoa(day), strconv.Itoa(day)),
+			})
+			if !withYear {
+				return s
+			}
+			return s + l.locale.names.comma + yearText
+		}
+	}
+	return l.locale.localize("accessible.date", -1, map[string]interface{}{
+		"Day":   l.number(day),
+		"Month": l.cal.monthName(l.locale, year, month, true),
+		"Year":  yearText,
+	})
+}
+
+// weekday writes a full day with its weekday, e.g. "Sunday, January 1, 2023"
+func (l labelWriter) weekday(t time.Time) string {
+	weekday := l.locale.names.weekdays[t.Weekday()]
+	if _, ok := l.cal.(japanese); ok {
+		return l.date(t, true) + "(" + weekday + ")"
+	}
+	return l.locale.localize("accessible.weekday", -1, map[string]interface{}{
+		"Weekday": weekday,
+		"Date":    l.date(t, true),
+	})
+}
+
+// time writes the time of t, e.g. "14:30", or "9:05 AM" on the 12-hour clock
+func (l labelWriter) time(t time.Time) string {
+	_, isJapanese := l.cal.(japanese)
+	if l.locale.hour24 || isJapanese {
+		return string(appendTime(nil, t, true, l.locale.digits))
+	}
+
+	hour, minute := t.Hour(), t.Minute()
+	messageID := "accessible.am"
+	if hour >= 12 {
+		messageID = "accessible.pm"
+	}
+	hour = (hour+11)%12 + 1
+
+	// Full hours are shortened like in the compact form, e.g. "12 PM"
+	b := appendNumber(nil, hour, l.locale.digits)
+	var minutes string
+	if minute != 0 {
+		b = append(b, ':')
+		b = appendTwoDigitNumber(b, minute, l.locale.digits)
+		minutes = l.number(minute)
+	}
+	return l.locale.localize(messageID, -1, map[string]interface{}{
+		"Time":   string(b),
+		"Hour":   l.number(hour),
+		"Minute": minutes,
+	})
+}
+
+// number writes n in the numbering system of the label
+func (l labelWriter) number(n int) string {
+	return formatNumber(n, l.locale.digits)
+}
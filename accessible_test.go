@@ -0,0 +1,129 @@
+package littledate
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAccessibleLabel(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	end := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+	}
+	at := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+	english := DateRangeFormatOptions{Today: today, Locale: "en_US", IncludeTime: true}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		options  DateRangeFormatOptions
+		expected string
+	}{
+		{"days", date(2023, 1, 1), end(2023, 1, 12), english, "January 1 to January 12, 2023"},
+		{"times today", at(2023, 11, 15, 12, 0), at(2023, 11, 15, 13, 0), english, "12 PM to 1 PM"},
+		{"times", at(2023, 1, 1, 9, 5), at(2023, 1, 1, 14, 30), english, "January 1, 2023, 9:05 AM to 2:30 PM"},
+		{"across days with times", at(2023, 1, 1, 9, 0), at(2023, 1, 2, 17, 0), english, "January 1, 9 AM to January 2, 2023, 5 PM"},
+		{"across years", date(2022, 12, 30), end(2023, 1, 2), english, "December 30, 2022 to January 2, 2023"},
+		{"full day", date(2023, 1, 1), end(2023, 1, 1), english, "Sunday, January 1, 2023"},
+		{"full month", date(2023, 1, 1), end(2023, 1, 31), english, "January 2023"},
+		{"months", date(2023, 1, 1), end(2023, 2, 28), english, "January to February 2023"},
+		{"months across years", date(2022, 12, 1), end(2023, 1, 31), english, "December 2022 to January 2023"},
+		{"quarter", date(2023, 1, 1), end(2023, 3, 31), english, "Quarter 1, 2023"},
+		{"year", date(2022, 1, 1), end(2022, 12, 31), english, "2022"},
+		{"years", date(2022, 1, 1), end(2023, 12, 31), english, "2022 to 2023"},
+		{"24-hour clock", at(2023, 1, 1, 9, 0), at(2023, 1, 1, 14, 30), DateRangeFormatOptions{Today: today, Locale: "de", IncludeTime: true}, "1. Januar 2023, 9:00 bis 14:30"},
+		{"french", date(2023, 1, 1), end(2023, 1, 12), DateRangeFormatOptions{Today: today, Locale: "fr"}, "du 1 janvier au 12 janvier 2023"},
+		{"korean", at(2023, 11, 15, 9, 30), at(2023, 11, 15, 13, 0), DateRangeFormatOptions{Today: today, Locale: "ko", IncludeTime: true}, "오전 9시 30분부터 오후 1시까지"},
+		{"japanese", at(2023, 1, 1, 9, 5), at(2023, 1, 1, 14, 0), DateRangeFormatOptions{Today: today, Locale: "ja", IncludeTime: true}, "2023年1月1日, 午前9時5分から午後2時まで"},
+		{"arabic", date(2023, 1, 1), end(2023, 1, 12), DateRangeFormatOptions{Today: today, Locale: "ar"}, "من ١ يناير إلى ١٢ يناير ٢٠٢٣"},
+		{"urdu", at(2023, 11, 15, 12, 0), at(2023, 11, 15, 13, 0), DateRangeFormatOptions{Today: today, Locale: "ur", IncludeTime: true}, "12 PM سے 1 PM تک"},
+		{"japanese era", date(2019, 4, 29), end(2019, 5, 2), DateRangeFormatOptions{Today: today, Locale: "ja", Calendar: JapaneseEraShort}, "平成31年4月29日から令和元年5月2日まで"},
+		{"japanese era quarter", date(2023, 1, 1), end(2023, 3, 31), DateRangeFormatOptions{Today: today, Locale: "ja", Calendar: JapaneseEra}, "令和5年第1四半期"},
+		{"lunar", date(2023, 1, 22), end(2023, 1, 26), DateRangeFormatOptions{Today: today, Locale: "en", Calendar: Chinese}, "First Month 1 to First Month 5, 2023"},
+		{"hijri", date(2023, 3, 23), end(2023, 4, 1), DateRangeFormatOptions{Today: today, Calendar: IslamicUmmAlQura}, "Ramadan 1 to Ramadan 10, 1444"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := AccessibleLabel(tt.from, tt.to, tt.options)
+			if result != tt.expected {
+				t.Errorf("AccessibleLabel() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAccessibleLabelLocales(t *testing.T) {
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 1, 12, 23, 59, 59, 999999999, time.UTC)
+
+	// Locales putting the month before the day, the day before the month and
+	// the year first
+	expected := map[string]string{
+		"en": "January 1 to January 12, 2023",
+		"de": "1. Januar bis 12. Januar 2023",
+		"fr": "du 1 janvier au 12 janvier 2023",
+		"ar": "من ١ يناير إلى ١٢ يناير ٢٠٢٣",
+		"ja": "2023年1月1日から1月12日まで",
+		"ko": "2023년 1월 1일부터 1월 12일까지",
+	}
+
+	// Every locale has its own words, and no label keeps the separator
+	for _, locale := range supportedLocales {
+		t.Run(locale, func(t *testing.T) {
+			options := DateRangeFormatOptions{Today: today, Locale: locale}
+			result := AccessibleLabel(from, to, options)
+			if want, ok := expected[locale]; ok && result != want {
+				t.Errorf("AccessibleLabel() = %v, want %v", result, want)
+			}
+			if locale != "en" && result == AccessibleLabel(from, to, DateRangeFormatOptions{Today: today, Locale: "en"}) {
+				t.Errorf("AccessibleLabel() = %v, want a translated label", result)
+			}
+			if strings.Contains(result, " - ") {
+				t.Errorf("AccessibleLabel() = %v, want the separator spelled out", result)
+			}
+		})
+	}
+}
+
+func TestAccessibleLabelLocaleOrder(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	end := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		locale   string
+		expected string
+	}{
+		{"ja years", date(2022, 1, 1), end(2023, 12, 31), "ja", "2022年から2023年まで"},
+		{"ko years", date(2022, 1, 1), end(2023, 12, 31), "ko", "2022년부터 2023년까지"},
+		{"ja month", date(2023, 1, 1), end(2023, 1, 31), "ja", "2023年1月"},
+		{"ko months across years", date(2022, 12, 1), end(2023, 1, 31), "ko", "2022년 12월부터 2023년 1월까지"},
+		{"es month", date(2023, 1, 1), end(2023, 1, 31), "es", "Enero de 2023"},
+		{"ja weekday", date(2023, 1, 1), end(2023, 1, 1), "ja", "2023年1月1日日曜日"},
+		{"ko weekday", date(2023, 1, 1), end(2023, 1, 1), "ko", "2023년 1월 1일 일요일"},
+		{"zh-CN weekday", date(2023, 1, 1), end(2023, 1, 1), "zh-CN", "2023年一月1日星期日"},
+		{"fr weekday", date(2023, 1, 1), end(2023, 1, 1), "fr", "dimanche 1 janvier 2023"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := AccessibleLabel(tt.from, tt.to, DateRangeFormatOptions{Today: today, Locale: tt.locale})
+			if result != tt.expected {
+				t.Errorf("AccessibleLabel() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
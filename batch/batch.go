@@ -0,0 +1,359 @@
+// Package batch adds formatted date ranges to CSV and JSON Lines files.
+//
+// Each record is copied to the output with an extra column (or field)
+// holding the range formatted by littledate.FormatDateRange. Records whose
+// range cannot be parsed are kept, with an empty formatted range, and
+// reported in the returned Report instead of aborting the whole file.
+// Malformed CSV rows, such as rows with a stray quote, cannot be copied as
+// they were and are dropped from the output, but reported the same way.
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	littledate "github.com/hnq90/little-date-go"
+	"github.com/hnq90/little-date-go/internal/timeparse"
+)
+
+// Format is the file format of the input and output
+type Format int
+
+const (
+	// CSV is comma-separated values with a header row
+	CSV Format = iota
+
+	// JSONLines is one JSON object per line
+	JSONLines
+)
+
+// Options configures how records are enriched.
+type Options struct {
+	// Format is the file format of the input and output. Default is CSV.
+	Format Format
+
+	// StartColumn and EndColumn name the columns (or JSON fields) holding the
+	// start and end of each range. Values may be RFC 3339 timestamps, local
+	// date-times or plain dates; a plain end date includes the whole day.
+	// If not specified, "start" and "end" will be used.
+	StartColumn string
+	EndColumn   string
+
+	// OutputColumn names the column that receives the formatted range.
+	// An existing column with the same name is replaced.
+	// If not specified, "range" will be used.
+	OutputColumn string
+
+	// LocaleColumn optionally names a column holding a per-record locale.
+	// Empty values use FormatOptions.Locale.
+	LocaleColumn string
+
+	// TimeZoneColumn optionally names a column holding a per-record IANA
+	// time zone. Empty values use Location.
+	TimeZoneColumn string
+
+	// Location is the time zone used to interpret values without a UTC offset
+	// and to display all ranges. If not specified, UTC will be used.
+	Location *time.Location
+
+	// FormatOptions are passed to littledate.FormatDateRange for every record.
+	// A zero Today is replaced by the current time in the record's time zone.
+	FormatOptions littledate.DateRangeFormatOptions
+}
+
+// RowError describes a record whose range could not be formatted.
+type RowError struct {
+	// Row is the 1-based index of the record, not counting the CSV header.
+	Row int
+
+	// Err is the reason the record could not be formatted.
+	Err error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Report summarizes an Enrich run.
+type Report struct {
+	// Rows is the number of records read, not counting the CSV header.
+	Rows int
+
+	// Errors lists the records that could not be formatted, including
+	// malformed CSV rows, which are left out of the output.
+	Errors []*RowError
+}
+
+// Enrich reads records from r and writes them to w with an added formatted
+// range. It only returns an error if the input cannot be read or written as
+// a whole, e.g. because a configured column is missing from the CSV header.
+func Enrich(r io.Reader, w io.Writer, options Options) (*Report, error) {
+	e := newEnricher(options)
+	switch options.Format {
+	case CSV:
+		return e.enrichCSV(r, w)
+	case JSONLines:
+		return e.enrichJSONLines(r, w)
+	default:
+		return nil, fmt.Errorf("batch: unknown format %d", options.Format)
+	}
+}
+
+// ParseFormat returns the format with the given name: "csv", "jsonl" or "ndjson"
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToLower(name) {
+	case "csv":
+		return CSV, nil
+	case "jsonl", "ndjson", "jsonlines":
+		return JSONLines, nil
+	default:
+		return 0, fmt.Errorf("batch: unknown format %q", name)
+	}
+}
+
+// enricher formats the ranges of individual records
+type enricher struct {
+	options   Options
+	locations map[string]*time.Location
+}
+
+func newEnricher(options Options) *enricher {
+	if options.StartColumn == "" {
+		options.StartColumn = "start"
+	}
+	if options.EndColumn == "" {
+		options.EndColumn = "end"
+	}
+	if options.OutputColumn == "" {
+		options.OutputColumn = "range"
+	}
+	if options.Location == nil {
+		options.Location = time.UTC
+	}
+	return &enricher{options: options, locations: make(map[string]*time.Location)}
+}
+
+// format formats a single range using the record's locale and time zone, if any
+func (e *enricher) format(start, end, locale, tz string) (string, error) {
+	if start == "" {
+		return "", fmt.Errorf("missing %s", e.options.StartColumn)
+	}
+	if end == "" {
+		return "", fmt.Errorf("missing %s", e.options.EndColumn)
+	}
+
+	location := e.options.Location
+	if tz != "" {
+		var ok bool
+		if location, ok = e.locations[tz]; !ok {
+			loaded, err := time.LoadLocation(tz)
+			if err != nil {
+				return "", fmt.Errorf("invalid time zone %q", tz)
+			}
+			e.locations[tz] = loaded
+			location = loaded
+		}
+	}
+
+	from, to, err := timeparse.ParseRange(start, end, location)
+	if err != nil {
+		return "", err
+	}
+
+	options := e.options.FormatOptions
+	options.Location = location
+	if locale != "" {
+		options.Locale = locale
+	}
+	if options.Today.IsZero() {
+		options.Today = time.Now().In(location)
+	} else {
+		options.Today = options.Today.In(location)
+	}
+	return littledate.FormatDateRange(from, to, options), nil
+}
+
+func (e *enricher) enrichCSV(r io.Reader, w io.Writer) (*Report, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	writer := csv.NewWriter(w)
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return &Report{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("batch: reading header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[name] = i
+	}
+	column := func(name string) (int, error) {
+		if name == "" {
+			return -1, nil
+		}
+		i, ok := columns[name]
+		if !ok {
+			return -1, fmt.Errorf("batch: column %q not found in header", name)
+		}
+		return i, nil
+	}
+
+	var indexes [4]int
+	for i, name := range []string{e.options.StartColumn, e.options.EndColumn, e.options.LocaleColumn, e.options.TimeZoneColumn} {
+		if indexes[i], err = column(name); err != nil {
+			return nil, err
+		}
+	}
+
+	outputIndex, ok := columns[e.options.OutputColumn]
+	if !ok {
+		outputIndex = len(header)
+		header = append(header, e.options.OutputColumn)
+	}
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		report.Rows++
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return report, err
+			}
+			// The reader only returns the fields before the error, so the row is dropped
+			report.Errors = append(report.Errors, &RowError{Row: report.Rows, Err: err})
+			continue
+		}
+
+		field := func(i int) string {
+			if i < 0 || i >= len(record) {
+				return ""
+			}
+			return record[i]
+		}
+
+		formatted, err := e.format(field(indexes[0]), field(indexes[1]), field(indexes[2]), field(indexes[3]))
+		if err != nil {
+			report.Errors = append(report.Errors, &RowError{Row: report.Rows, Err: err})
+		}
+
+		for len(record) <= outputIndex {
+			record = append(record, "")
+		}
+		record[outputIndex] = formatted
+		if err := writer.Write(record); err != nil {
+			return report, err
+		}
+	}
+
+	writer.Flush()
+	return report, writer.Error()
+}
+
+func (e *enricher) enrichJSONLines(r io.Reader, w io.Writer) (*Report, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	writer := bufio.NewWriter(w)
+
+	report := &Report{}
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		report.Rows++
+
+		enriched, err := e.enrichJSONObject(line)
+		if err != nil {
+			report.Errors = append(report.Errors, &RowError{Row: report.Rows, Err: err})
+		}
+		writer.Write(enriched)
+		writer.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		return report, err
+	}
+
+	return report, writer.Flush()
+}
+
+// enrichJSONObject adds the formatted range to a JSON object. The object is
+// returned unchanged if it cannot be parsed, and with an empty range if the
+// range cannot be formatted.
+func (e *enricher) enrichJSONObject(line []byte) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(line, &fields); err != nil {
+		return line, fmt.Errorf("invalid JSON object: %w", err)
+	}
+	if fields == nil {
+		return line, errors.New("invalid JSON object: null")
+	}
+
+	value := func(name string) (string, error) {
+		raw, ok := fields[name]
+		if name == "" || !ok || string(raw) == "null" {
+			return "", nil
+		}
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return "", fmt.Errorf("field %q is not a string", name)
+		}
+		return s, nil
+	}
+
+	var formatted string
+	var err error
+	values := make([]string, 4)
+	for i, name := range []string{e.options.StartColumn, e.options.EndColumn, e.options.LocaleColumn, e.options.TimeZoneColumn} {
+		if values[i], err = value(name); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		formatted, err = e.format(values[0], values[1], values[2], values[3])
+	}
+
+	encoded, _ := json.Marshal(formatted)
+	if _, exists := fields[e.options.OutputColumn]; exists {
+		// Replace the existing field. Keys are written in sorted order.
+		fields[e.options.OutputColumn] = encoded
+		replaced, marshalErr := json.Marshal(fields)
+		if marshalErr != nil {
+			return line, marshalErr
+		}
+		return replaced, err
+	}
+
+	// Append the field, keeping the original object as it is
+	key, _ := json.Marshal(e.options.OutputColumn)
+	enriched := append([]byte(nil), line[:len(line)-1]...)
+	enriched = bytes.TrimRight(enriched, " \t")
+	if len(fields) > 0 {
+		enriched = append(enriched, ',')
+	}
+	enriched = append(enriched, key...)
+	enriched = append(enriched, ':')
+	enriched = append(enriched, encoded...)
+	enriched = append(enriched, '}')
+	return enriched, err
+}
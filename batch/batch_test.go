@@ -0,0 +1,129 @@
+package batch
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	littledate "github.com/hnq90/little-date-go"
+)
+
+var today = time.Date(2023, 11, 15, 12, 0, 0, 0, time.UTC)
+
+func TestEnrichCSV(t *testing.T) {
+	input := `id,start,end,locale,tz
+1,2023-01-01,2023-01-12,,
+2,2023-01-01T00:00:00Z,2023-01-01T05:00:00Z,,Asia/Tokyo
+3,2023-01-01,2023-01-31,de,
+4,yesterday,2023-01-31,,
+5,2023-01-01,2023-01-31,,Mars/Olympus
+`
+	expected := `id,start,end,locale,tz,range
+1,2023-01-01,2023-01-12,,,Jan 1 - 12
+2,2023-01-01T00:00:00Z,2023-01-01T05:00:00Z,,Asia/Tokyo,"Jan 1, 9am - 2pm"
+3,2023-01-01,2023-01-31,de,,Januar 2023
+4,yesterday,2023-01-31,,,
+5,2023-01-01,2023-01-31,,Mars/Olympus,
+`
+
+	var output bytes.Buffer
+	report, err := Enrich(strings.NewReader(input), &output, Options{
+		LocaleColumn:   "locale",
+		TimeZoneColumn: "tz",
+		FormatOptions:  littledate.DateRangeFormatOptions{Today: today, IncludeTime: true},
+	})
+	if err != nil {
+		t.Fatalf("Enrich() error = %v", err)
+	}
+	if output.String() != expected {
+		t.Errorf("Enrich() output =\n%s\nwant\n%s", output.String(), expected)
+	}
+	if report.Rows != 5 || len(report.Errors) != 2 || report.Errors[0].Row != 4 || report.Errors[1].Row != 5 {
+		t.Errorf("Enrich() report = %+v, want 5 rows with errors in rows 4 and 5", report)
+	}
+}
+
+func TestEnrichCSVMalformedRows(t *testing.T) {
+	input := "start,end\n2023-01-01,2023-01-12\n2023-01-01,2023\"01-31\n2023-02-01,2023-02-28\n"
+	expected := "start,end,range\n2023-01-01,2023-01-12,Jan 1 - 12\n2023-02-01,2023-02-28,February 2023\n"
+
+	var output bytes.Buffer
+	report, err := Enrich(strings.NewReader(input), &output, Options{
+		FormatOptions: littledate.DateRangeFormatOptions{Today: today},
+	})
+	if err != nil {
+		t.Fatalf("Enrich() error = %v", err)
+	}
+	if output.String() != expected {
+		t.Errorf("Enrich() output = %q, want %q", output.String(), expected)
+	}
+	if report.Rows != 3 || len(report.Errors) != 1 || report.Errors[0].Row != 2 {
+		t.Errorf("Enrich() report = %+v, want 3 rows with an error in row 2", report)
+	}
+}
+
+func TestEnrichCSVColumns(t *testing.T) {
+	input := "from,to,label\n2023-01-01,2023-03-31,old\n"
+	expected := "from,to,label\n2023-01-01,2023-03-31,Q1 2023\n"
+
+	var output bytes.Buffer
+	_, err := Enrich(strings.NewReader(input), &output, Options{
+		StartColumn:   "from",
+		EndColumn:     "to",
+		OutputColumn:  "label",
+		FormatOptions: littledate.DateRangeFormatOptions{Today: today},
+	})
+	if err != nil {
+		t.Fatalf("Enrich() error = %v", err)
+	}
+	if output.String() != expected {
+		t.Errorf("Enrich() output = %q, want %q", output.String(), expected)
+	}
+
+	_, err = Enrich(strings.NewReader(input), &output, Options{})
+	if err == nil {
+		t.Errorf("Enrich() with missing columns error = nil, want an error")
+	}
+}
+
+func TestEnrichJSONLines(t *testing.T) {
+	input := `{"id": 1, "start": "2023-01-01", "end": "2023-01-12"}
+{"id":2,"start":"2023-01-01","end":"2023-01-31","locale":"fr"}
+
+{"id":3,"start":"2023-01-01","end":"2023-01-31","range":"old"}
+{"id":4,"start":"2023-01-01"}
+not json
+null
+{"id":7,"start":2023,"end":"2023-01-31"}
+`
+	expected := `{"id": 1, "start": "2023-01-01", "end": "2023-01-12","range":"Jan 1 - 12"}
+{"id":2,"start":"2023-01-01","end":"2023-01-31","locale":"fr","range":"janvier 2023"}
+{"end":"2023-01-31","id":3,"range":"January 2023","start":"2023-01-01"}
+{"id":4,"start":"2023-01-01","range":""}
+not json
+null
+{"id":7,"start":2023,"end":"2023-01-31","range":""}
+`
+
+	var output bytes.Buffer
+	report, err := Enrich(strings.NewReader(input), &output, Options{
+		Format:        JSONLines,
+		LocaleColumn:  "locale",
+		FormatOptions: littledate.DateRangeFormatOptions{Today: today},
+	})
+	if err != nil {
+		t.Fatalf("Enrich() error = %v", err)
+	}
+	if output.String() != expected {
+		t.Errorf("Enrich() output =\n%s\nwant\n%s", output.String(), expected)
+	}
+
+	var rows []int
+	for _, rowErr := range report.Errors {
+		rows = append(rows, rowErr.Row)
+	}
+	if report.Rows != 7 || len(rows) != 4 || rows[0] != 4 || rows[1] != 5 || rows[2] != 6 || rows[3] != 7 {
+		t.Errorf("Enrich() report = %d rows, errors in rows %v, want 7 rows, errors in rows [4 5 6 7]", report.Rows, rows)
+	}
+}
@@ -0,0 +1,189 @@
+package littledate
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+// BidiMode chooses how the numbers of the output are protected from the
+// Unicode bidirectional algorithm. Without protection, a number next to
+// right-to-left text can trade places with the separator or the number
+// beside it, e.g. when "١ - ١٢ يناير" is shown inside an English sentence.
+type BidiMode int
+
+const (
+	// BidiNone leaves the output as it is
+	BidiNone BidiMode = iota
+
+	// BidiIsolate wraps each number in FIRST STRONG ISOLATE (U+2068) and
+	// POP DIRECTIONAL ISOLATE (U+2069), so that it is laid out on its own
+	// whatever surrounds it
+	BidiIsolate
+
+	// BidiMarks puts the mark of the locale's direction on both sides of
+	// each number: RIGHT-TO-LEFT MARK (U+200F) in right-to-left locales and
+	// LEFT-TO-RIGHT MARK (U+200E) in others. Marks are also understood by
+	// older renderers that do not support isolates.
+	BidiMarks
+)
+
+// The bidi control characters written around numbers
+const (
+	firstStrongIsolate    = "\u2068"
+	popDirectionalIsolate = "\u2069"
+	leftToRightMark       = "\u200e"
+	rightToLeftMark       = "\u200f"
+)
+
+// bidiModes maps the names accepted by ParseBidiMode to their mode
+var bidiModes = map[string]BidiMode{
+	"none":    BidiNone,
+	"isolate": BidiIsolate,
+	"marks":   BidiMarks,
+}
+
+// ParseBidiMode returns the bidi mode with the given name: "none", "isolate"
+// or "marks". It is meant for configuration files and command lines.
+func ParseBidiMode(name string) (BidiMode, error) {
+	mode, ok := bidiModes[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return BidiNone, fmt.Errorf("littledate: unknown bidi mode %q", name)
+	}
+	return mode, nil
+}
+
+// rightToLeftScripts are the scripts written from right to left
+var rightToLeftScripts = map[string]bool{
+	"Adlm": true,
+	"Arab": true,
+	"Hebr": true,
+	"Mand": true,
+	"Nkoo": true,
+	"Rohg": true,
+	"Samr": true,
+	"Syrc": true,
+	"Thaa": true,
+}
+
+// IsRightToLeft reports whether the translations used for a locale are
+// written from right to left, e.g. for "ar", "he", "fa" and "ur". It is meant
+// for setting the direction of the element the output is shown in.
+func IsRightToLeft(locale string) bool {
+	if locale == "" {
+		locale = defaultLocale
+	}
+	return lookupLocale(locale).rtl
+}
+
+// isRightToLeft reports whether the script of a language tag is written from right to left
+func isRightToLeft(tag language.Tag) bool {
+	script, _ := tag.Script()
+	return rightToLeftScripts[script.String()]
+}
+
+// protectNumbers protects the numbers of b[start:] as chosen by options.Bidi
+func protectNumbers(b []byte, start int, options DateRangeFormatOptions) []byte {
+	if options.Bidi == BidiNone {
+		return b
+	}
+	return isolateNumbers(b, start, options.Bidi, IsRightToLeft(options.Locale))
+}
+
+// protectNumbersString is protectNumbers for phrases that are built as strings
+func protectNumbersString(s string, options DateRangeFormatOptions) string {
+	if options.Bidi == BidiNone {
+		return s
+	}
+	return string(isolateNumbers([]byte(s), 0, options.Bidi, IsRightToLeft(options.Locale)))
+}
+
+// isolateNumbers surrounds each number of b[start:] with isolates or marks
+func isolateNumbers(b []byte, start int, mode BidiMode, rtl bool) []byte {
+	open, close := firstStrongIsolate, popDirectionalIsolate
+	switch {
+	case mode == BidiMarks && rtl:
+		open, close = rightToLeftMark, rightToLeftMark
+	case mode == BidiMarks:
+		open, close = leftToRightMark, leftToRightMark
+	case mode != BidiIsolate:
+		return b
+	}
+
+	first := start
+	for first < len(b) && numberLength(b[first:]) == 0 {
+		first++
+	}
+	if first == len(b) {
+		return b
+	}
+
+	// Rewrite the tail from a copy, which stays on the stack for short output
+	var buf [128]byte
+	tail := append(buf[:0], b[first:]...)
+	b = b[:first]
+	for i := 0; i < len(tail); {
+		n := numberLength(tail[i:])
+		if n == 0 {
+			_, size := utf8.DecodeRune(tail[i:])
+			b = append(b, tail[i:i+size]...)
+			i += size
+			continue
+		}
+		b = append(b, open...)
+		b = append(b, tail[i:i+n]...)
+		b = append(b, close...)
+		i += n
+	}
+	return b
+}
+
+// numberLength returns the length of the number at the start of s, or 0.
+// A number is a run of digits of any numbering system that may be split by
+// ':', '.' or '/', e.g. "9:05", and may start with the apostrophe of a
+// two-digit year, e.g. "'22", or end with "am" or "pm", e.g. "2:30pm".
+func numberLength(s []byte) int {
+	i := 0
+	if len(s) > 0 && s[0] == '\'' {
+		i = 1
+	}
+	if digitLength(s[i:]) == 0 {
+		return 0
+	}
+	for i < len(s) {
+		if n := digitLength(s[i:]); n > 0 {
+			i += n
+			continue
+		}
+		if (s[i] == ':' || s[i] == '.' || s[i] == '/') && digitLength(s[i+1:]) > 0 {
+			i++
+			continue
+		}
+		break
+	}
+	if rest := s[i:]; len(rest) >= 2 && (rest[0] == 'a' || rest[0] == 'p') && rest[1] == 'm' {
+		i += 2
+	}
+	return i
+}
+
+// digitLength returns the length of the decimal digit at the start of s, or 0
+func digitLength(s []byte) int {
+	if len(s) == 0 {
+		return 0
+	}
+	if s[0] < utf8.RuneSelf {
+		if s[0] >= '0' && s[0] <= '9' {
+			return 1
+		}
+		return 0
+	}
+	r, size := utf8.DecodeRune(s)
+	if unicode.Is(unicode.Nd, r) {
+		return size
+	}
+	return 0
+}
@@ -0,0 +1,159 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRightToLeftLayout(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	end := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+	}
+	arabic := DateRangeFormatOptions{Today: today, Locale: "ar"}
+	hebrew := DateRangeFormatOptions{Today: today, Locale: "he"}
+	persian := DateRangeFormatOptions{Today: today, Locale: "fa"}
+	urdu := DateRangeFormatOptions{Today: today, Locale: "ur"}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		options  DateRangeFormatOptions
+		expected string
+	}{
+		{"arabic days", date(2023, 1, 1), end(2023, 1, 12), arabic, "١ - ١٢ يناير"},
+		{"arabic past year", date(2022, 1, 1), end(2022, 1, 12), arabic, "١ - ١٢ يناير، ٢٠٢٢"},
+		{"arabic across months", date(2023, 1, 3), end(2023, 4, 20), arabic, "٣ يناير - ٢٠ أبريل"},
+		{"arabic full month", date(2023, 1, 1), end(2023, 1, 31), arabic, "يناير ٢٠٢٣"},
+		{"hebrew days", date(2023, 1, 1), end(2023, 1, 12), hebrew, "1 - 12 ינו׳"},
+		{"hebrew full day", date(2022, 1, 1), end(2022, 1, 1), hebrew, "שבת, 1 ינו׳, 2022"},
+		{"persian across years", date(2022, 12, 30), end(2023, 1, 2), persian, "۳۰ دسامبر '۲۲ - ۲ ژانویه '۲۳"},
+		{"urdu days", date(2022, 1, 1), end(2022, 1, 12), urdu, "1 - 12 جنوری، 2022"},
+		{"urdu full day", date(2023, 12, 1), end(2023, 12, 1), urdu, "جمعہ، 1 دسمبر"},
+		{"hebrew calendar", date(2023, 9, 16), end(2023, 9, 20), DateRangeFormatOptions{Today: today, Locale: "he", Calendar: Hebrew}, "1 - 5 תשרי"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDateRange(tt.from, tt.to, tt.options)
+			if result != tt.expected {
+				t.Errorf("FormatDateRange() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRightToLeftDates(t *testing.T) {
+	day := func(month time.Month, day int) time.Time {
+		return time.Date(2023, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	urdu := DateRangeFormatOptions{Today: today, Locale: "ur"}
+
+	tests := []struct {
+		name     string
+		days     []time.Time
+		expected string
+	}{
+		{"runs in one month", []time.Time{day(1, 1), day(1, 2), day(1, 3), day(1, 5)}, "1 - 3، 5 جنوری"},
+		{"run across months", []time.Time{day(1, 30), day(1, 31), day(2, 1), day(2, 2), day(2, 6)}, "30 جنوری - 2، 6 فروری"},
+		{"single days", []time.Time{day(1, 5), day(2, 6)}, "5 جنوری، 6 فروری"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDates(tt.days, urdu)
+			if result != tt.expected {
+				t.Errorf("FormatDates() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBidi(t *testing.T) {
+	jan1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	jan12 := time.Date(2023, 1, 12, 23, 59, 59, 999999999, time.UTC)
+
+	// The expected output is in logical order, as it is stored and read
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{"isolated numbers", FormatDateRange(jan1, jan12, DateRangeFormatOptions{Today: today, Locale: "ar", Bidi: BidiIsolate}),
+			"\u2068١\u2069 - \u2068١٢\u2069 يناير"},
+		{"right-to-left marks", FormatDateRange(jan1, jan12, DateRangeFormatOptions{Today: today, Locale: "he", Bidi: BidiMarks}),
+			"\u200f1\u200f - \u200f12\u200f ינו׳"},
+		{"left-to-right marks", FormatDateRange(jan1, jan12, DateRangeFormatOptions{Today: today, Locale: "en", Bidi: BidiMarks}),
+			"Jan \u200e1\u200e - \u200e12\u200e"},
+		{"times", FormatDateRange(time.Date(2022, 12, 30, 9, 5, 0, 0, time.UTC), time.Date(2022, 12, 30, 14, 30, 0, 0, time.UTC),
+			DateRangeFormatOptions{Today: today, Locale: "ur", IncludeTime: true, Bidi: BidiIsolate}),
+			"\u206830\u2069 دسمبر، \u20689:05am\u2069 - \u20682:30pm\u2069، \u20682022\u2069"},
+		{"two-digit years", FormatDateRange(time.Date(2022, 12, 30, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 23, 59, 59, 0, time.UTC),
+			DateRangeFormatOptions{Today: today, Locale: "he", Bidi: BidiIsolate}),
+			"\u206830\u2069 דצמ׳ \u2068'22\u2069 - \u20682\u2069 ינו׳ \u2068'23\u2069"},
+		{"phrase", FormatRelative(today.AddDate(0, 0, 3), DateRangeFormatOptions{Today: today, Locale: "fa", Bidi: BidiIsolate}),
+			"\u2068۳\u2069 روز بعد"},
+		{"list", FormatDates([]time.Time{jan1, jan12}, DateRangeFormatOptions{Today: today, Locale: "ar", Bidi: BidiIsolate}),
+			"\u2068١\u2069، \u2068١٢\u2069 يناير"},
+		{"none", FormatDateRange(jan1, jan12, DateRangeFormatOptions{Today: today, Locale: "ar"}), "١ - ١٢ يناير"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("result = %+q, want %+q", tt.result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsRightToLeft(t *testing.T) {
+	tests := []struct {
+		locale   string
+		expected bool
+	}{
+		{"ar-EG", true},
+		{"he", true},
+		{"fa_IR", true},
+		{"ur-PK", true},
+		{"en", false},
+		{"zh-TW", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			if result := IsRightToLeft(tt.locale); result != tt.expected {
+				t.Errorf("IsRightToLeft(%q) = %v, want %v", tt.locale, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseBidiMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected BidiMode
+		wantErr  bool
+	}{
+		{"none", BidiNone, false},
+		{"Isolate", BidiIsolate, false},
+		{" marks ", BidiMarks, false},
+		{"embed", BidiNone, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mode, err := ParseBidiMode(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseBidiMode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if mode != tt.expected {
+				t.Errorf("ParseBidiMode() = %v, want %v", mode, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package littledate
+
+import "time"
+
+// buddhistEraOffset is the number of years the Buddhist Era is ahead of the Common Era
+const buddhistEraOffset = 543
+
+// Buddhist is the Thai solar calendar. It has the months and days of the
+// Gregorian calendar and counts years in the Buddhist Era, so 2023 is 2566.
+//
+// Examples:
+// - ม.ค. 1 - 12, 2565
+// - Q1 2566
+// - ธ.ค. 30 '65 - ม.ค. 2 '66
+var Buddhist Calendar = buddhist{}
+
+type buddhist struct {
+	gregorian
+}
+
+func (buddhist) Date(t time.Time) (int, int, int) {
+	year, month, day := t.Date()
+	return year + buddhistEraOffset, int(month), day
+}
+
+func (buddhist) Time(year, month, day int, loc *time.Location) time.Time {
+	return time.Date(year-buddhistEraOffset, time.Month(month), day, 0, 0, 0, 0, loc)
+}
+
+func (b buddhist) DaysInMonth(year, month int) int {
+	return b.gregorian.DaysInMonth(year-buddhistEraOffset, month)
+}
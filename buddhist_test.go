@@ -0,0 +1,55 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuddhistCalendar(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	end := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+	}
+	thai := DateRangeFormatOptions{Today: today, Locale: "th", Calendar: Buddhist}
+	english := DateRangeFormatOptions{Today: today, Locale: "en_US", Calendar: Buddhist}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		options  DateRangeFormatOptions
+		expected string
+	}{
+		{"this year", date(2023, 1, 1), end(2023, 1, 12), thai, "ม.ค. 1 - 12"},
+		{"past year", date(2022, 1, 1), end(2022, 1, 12), thai, "ม.ค. 1 - 12, 2565"},
+		{"full year", date(2023, 1, 1), end(2023, 12, 31), thai, "2566"},
+		{"quarter", date(2023, 1, 1), end(2023, 3, 31), thai, "Q1 2566"},
+		{"full month", date(2023, 2, 1), end(2023, 2, 28), thai, "กุมภาพันธ์ 2566"},
+		{"leap February", date(2024, 2, 1), end(2024, 2, 29), thai, "กุมภาพันธ์ 2567"},
+		{"across years", date(2022, 12, 30), end(2023, 1, 2), thai, "ธ.ค. 30 '65 - ม.ค. 2 '66"},
+		{"single day", date(2022, 1, 1), end(2022, 1, 1), english, "Sat, Jan 1, 2565"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDateRange(tt.from, tt.to, tt.options)
+			if result != tt.expected {
+				t.Errorf("FormatDateRange() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuddhistDates(t *testing.T) {
+	options := DateRangeFormatOptions{Today: today, Locale: "th", Calendar: Buddhist}
+	days := []time.Time{
+		time.Date(2022, 12, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC),
+	}
+	if got, want := FormatDates(days, options), "ธ.ค. 30 - 31, 2565, ม.ค. 3"; got != want {
+		t.Errorf("FormatDates() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,184 @@
+package littledate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Calendar is a calendar system. It decides the year, month and day shown
+// for a time, and where months, quarters and years begin and end, so that
+// for example a full month of the calendar collapses to its name.
+//
+// The available calendars are Gregorian, Buddhist, JapaneseEra,
+// JapaneseEraShort, IslamicCivil, IslamicUmmAlQura, Persian, Hebrew,
+// Chinese and Vietnamese.
+type Calendar interface {
+	// Date returns the year, month and day of t in the calendar. Months are
+	// numbered from 1 in the order they occur in the year.
+	Date(t time.Time) (year, month, day int)
+
+	// Time returns the start of a day of the calendar in loc
+	Time(year, month, day int, loc *time.Location) time.Time
+
+	// MonthsInYear returns the number of months in a year
+	MonthsInYear(year int) int
+
+	// DaysInMonth returns the number of days in a month
+	DaysInMonth(year, month int) int
+
+	// monthName returns the long or short name of a month in a locale
+	monthName(locale *localeData, year, month int, long bool) string
+}
+
+// rangeWriter is implemented by calendars that write dates in a layout of
+// their own rather than the one of FormatDateRange
+type rangeWriter interface {
+	appendRange(b []byte, from, to time.Time, options DateRangeFormatOptions, locale *localeData) []byte
+	appendDate(b []byte, date time.Time, options DateRangeFormatOptions, locale *localeData) []byte
+}
+
+// Gregorian is the calendar of the time package. It is used when no calendar is set.
+var Gregorian Calendar = gregorian{}
+
+type gregorian struct{}
+
+func (gregorian) Date(t time.Time) (int, int, int) {
+	year, month, day := t.Date()
+	return year, int(month), day
+}
+
+func (gregorian) Time(year, month, day int, loc *time.Location) time.Time {
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+}
+
+func (gregorian) MonthsInYear(year int) int {
+	return 12
+}
+
+func (gregorian) DaysInMonth(year, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func (gregorian) monthName(locale *localeData, year, month int, long bool) string {
+	if long {
+		return locale.names.months[month-1]
+	}
+	return locale.names.shortMonths[month-1]
+}
+
+// calendars lists the calendars by the names accepted by ParseCalendar
+var calendars = map[string]Calendar{
+	"gregorian":        Gregorian,
+	"buddhist":         Buddhist,
+	"japanese":         JapaneseEra,
+	"japanese-short":   JapaneseEraShort,
+	"islamic-civil":    IslamicCivil,
+	"islamic-umalqura": IslamicUmmAlQura,
+	"persian":          Persian,
+	"hebrew":           Hebrew,
+	"chinese":          Chinese,
+	"vietnamese":       Vietnamese,
+}
+
+// ParseCalendar returns the calendar with the given name, e.g. "gregorian"
+// or "japanese". It is meant for configuration files and command lines.
+func ParseCalendar(name string) (Calendar, error) {
+	calendar, ok := calendars[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return nil, fmt.Errorf("littledate: unknown calendar %q", name)
+	}
+	return calendar, nil
+}
+
+// calendarOf returns the calendar set in the options, or Gregorian
+func calendarOf(options DateRangeFormatOptions) Calendar {
+	if options.Calendar == nil {
+		return Gregorian
+	}
+	return options.Calendar
+}
+
+func startOfCalendarMonth(cal Calendar, t time.Time) time.Time {
+	year, month, _ := cal.Date(t)
+	return cal.Time(year, month, 1, t.Location())
+}
+
+func endOfCalendarMonth(cal Calendar, t time.Time) time.Time {
+	year, month, _ := cal.Date(t)
+	return endOfDay(cal.Time(year, month, cal.DaysInMonth(year, month), t.Location()))
+}
+
+func startOfCalendarYear(cal Calendar, t time.Time) time.Time {
+	year, _, _ := cal.Date(t)
+	return cal.Time(year, 1, 1, t.Location())
+}
+
+func endOfCalendarYear(cal Calendar, t time.Time) time.Time {
+	year, _, _ := cal.Date(t)
+	last := cal.MonthsInYear(year)
+	return endOfDay(cal.Time(year, last, cal.DaysInMonth(year, last), t.Location()))
+}
+
+// calendarQuarter returns the quarter of t. Quarters only exist in years of
+// 12 months, and not at all in the lunisolar calendars, whose years are
+// counted in months that do not line up with the Gregorian quarters.
+func calendarQuarter(cal Calendar, t time.Time) (int, bool) {
+	if _, ok := cal.(lunisolar); ok {
+		return 0, false
+	}
+	year, month, _ := cal.Date(t)
+	if cal.MonthsInYear(year) != 12 {
+		return 0, false
+	}
+	return (month-1)/3 + 1, true
+}
+
+// isCalendarQuarter reports whether the range covers exactly one quarter
+func isCalendarQuarter(cal Calendar, from, to time.Time) bool {
+	quarter, ok := calendarQuarter(cal, from)
+	if !ok {
+		return false
+	}
+	if toQuarter, _ := calendarQuarter(cal, to); toQuarter != quarter {
+		return false
+	}
+
+	year, _, _ := cal.Date(from)
+	toYear, _, _ := cal.Date(to)
+	first, last := (quarter-1)*3+1, quarter*3
+	start := cal.Time(year, first, 1, from.Location())
+	end := endOfDay(cal.Time(toYear, last, cal.DaysInMonth(toYear, last), to.Location()))
+	return year == toYear && isSameMinute(start, from) && isSameMinute(end, to)
+}
+
+// localizedMonthName looks up the name of a month of a calendar other than
+// the Gregorian one, e.g. "calendar.islamic.month.long.9", using the English
+// name if the translation is missing
+func localizedMonthName(locale *localeData, calendar, month string, long bool, fallback string) string {
+	form := ".month.short."
+	if long {
+		form = ".month.long."
+	}
+	return localizeName(locale.localizer, "calendar."+calendar+form+month, fallback)
+}
+
+// epochDays returns the number of days between January 1, 1970 and the date of t
+func epochDays(t time.Time) int {
+	year, month, day := t.Date()
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// fromEpochDays returns the start of the day that is days after January 1, 1970, in loc
+func fromEpochDays(days int, loc *time.Location) time.Time {
+	return time.Date(1970, 1, 1+days, 0, 0, 0, 0, loc)
+}
+
+// floorDiv divides a by b, rounding towards negative infinity
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,50 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCalendar(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected Calendar
+		wantErr  bool
+	}{
+		{"gregorian", Gregorian, false},
+		{"buddhist", Buddhist, false},
+		{" Japanese ", JapaneseEra, false},
+		{"japanese-short", JapaneseEraShort, false},
+		{"islamic-umalqura", IslamicUmmAlQura, false},
+		{"persian", Persian, false},
+		{"hebrew", Hebrew, false},
+		{"Vietnamese", Vietnamese, false},
+		{"julian", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseCalendar(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCalendar() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseCalendar() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGregorianCalendar(t *testing.T) {
+	options := defaultOptions
+	options.Calendar = Gregorian
+
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, 1, 12, 23, 59, 59, 999999999, time.UTC)
+	if got, want := FormatDateRange(from, to, options), FormatDateRange(from, to, defaultOptions); got != want {
+		t.Errorf("FormatDateRange() = %v, want %v", got, want)
+	}
+	if got := Gregorian.DaysInMonth(2024, 2); got != 29 {
+		t.Errorf("DaysInMonth() = %v, want %v", got, 29)
+	}
+}
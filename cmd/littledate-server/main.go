@@ -0,0 +1,47 @@
+// Command littledate-server runs the httpapi formatting service standalone.
+//
+// Usage:
+//
+//	littledate-server [-addr :8080] [-locale en_US] [-tz UTC]
+//
+// See package httpapi for the request and response format.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hnq90/little-date-go/httpapi"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	locale := flag.String("locale", "en_US", "locale used when a request and its Accept-Language header specify none")
+	tz := flag.String("tz", "UTC", "IANA time zone used for requests without a tz")
+	separator := flag.String("separator", "", "separator between the start and end of each range (default \"-\")")
+	maxBatch := flag.Int("max-batch", 1000, "maximum number of ranges in a POST request")
+	flag.Parse()
+
+	location, err := time.LoadLocation(*tz)
+	if err != nil {
+		log.Fatalf("invalid time zone %q: %v", *tz, err)
+	}
+
+	server := &http.Server{
+		Addr: *addr,
+		Handler: httpapi.NewHandler(httpapi.Options{
+			DefaultLocale: *locale,
+			Location:      location,
+			Separator:     *separator,
+			MaxBatch:      *maxBatch,
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+	}
+
+	log.Printf("littledate-server listening on %s", *addr)
+	log.Fatal(server.ListenAndServe())
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hnq90/little-date-go/batch"
+)
+
+// runBatch executes the batch subcommand and returns the exit code
+func runBatch(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("littledate batch", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: littledate batch [flags] [FILE]")
+		fmt.Fprintln(fs.Output(), "Adds a formatted range column to a CSV or JSON Lines file, read from standard input if FILE is omitted.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	flags := addFormatFlags(fs)
+	format := fs.String("format", "", "input format, \"csv\" or \"jsonl\" (default from the file extension, or \"csv\")")
+	start := fs.String("start", "start", "column holding the start of the range")
+	end := fs.String("end", "end", "column holding the end of the range")
+	output := fs.String("column", "range", "column to write the formatted range to")
+	localeColumn := fs.String("locale-column", "", "optional column holding a per-row locale")
+	tzColumn := fs.String("tz-column", "", "optional column holding a per-row IANA time zone")
+
+	cfg, err := parseFlags(fs, flags, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, "littledate:", err)
+		return 2
+	}
+	if len(cfg.args) > 1 {
+		fmt.Fprintln(stderr, "littledate: too many arguments")
+		return 2
+	}
+
+	input := stdin
+	formatName := *format
+	if len(cfg.args) == 1 {
+		file, err := os.Open(cfg.args[0])
+		if err != nil {
+			fmt.Fprintln(stderr, "littledate:", err)
+			return 1
+		}
+		defer file.Close()
+		input = file
+
+		if formatName == "" {
+			if ext := filepath.Ext(cfg.args[0]); ext != "" {
+				formatName = ext[1:]
+			}
+		}
+	}
+	if formatName == "" {
+		formatName = "csv"
+	}
+	fileFormat, err := batch.ParseFormat(formatName)
+	if err != nil {
+		fmt.Fprintln(stderr, "littledate:", err)
+		return 2
+	}
+
+	report, err := batch.Enrich(input, stdout, batch.Options{
+		Format:         fileFormat,
+		StartColumn:    *start,
+		EndColumn:      *end,
+		OutputColumn:   *output,
+		LocaleColumn:   *localeColumn,
+		TimeZoneColumn: *tzColumn,
+		Location:       cfg.location,
+		FormatOptions:  cfg.options,
+	})
+	if err != nil {
+		fmt.Fprintln(stderr, "littledate:", err)
+		return 1
+	}
+
+	for _, rowErr := range report.Errors {
+		fmt.Fprintln(stderr, "littledate:", rowErr)
+	}
+	if len(report.Errors) > 0 {
+		return 1
+	}
+	return 0
+}
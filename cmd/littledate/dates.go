@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	littledate "github.com/hnq90/little-date-go"
+	"github.com/hnq90/little-date-go/internal/timeparse"
+)
+
+// runDates executes the dates subcommand and returns the exit code
+func runDates(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("littledate dates", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: littledate dates [flags] [DATE...]")
+		fmt.Fprintln(fs.Output(), "Collapses days into compact runs. Without arguments, dates are read from standard input, separated by white space.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	flags := addFormatFlags(fs)
+
+	cfg, err := parseFlags(fs, flags, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, "littledate:", err)
+		return 2
+	}
+
+	values := cfg.args
+	if len(values) == 0 {
+		scanner := bufio.NewScanner(stdin)
+		for scanner.Scan() {
+			values = append(values, strings.Fields(scanner.Text())...)
+		}
+		if err := scanner.Err(); err != nil {
+			fmt.Fprintln(stderr, "littledate:", err)
+			return 1
+		}
+	}
+
+	days := make([]time.Time, 0, len(values))
+	for _, value := range values {
+		day, _, err := timeparse.Parse(value, cfg.location)
+		if err != nil {
+			fmt.Fprintln(stderr, "littledate:", err)
+			return 1
+		}
+		days = append(days, day)
+	}
+
+	fmt.Fprintln(stdout, littledate.FormatDates(days, cfg.options))
+	return 0
+}
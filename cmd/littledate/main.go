@@ -0,0 +1,270 @@
+// Command littledate formats date ranges in a short, human-readable way.
+//
+// Usage:
+//
+//	littledate [flags] FROM TO
+//	littledate [flags] INTERVAL
+//	littledate [flags] < ranges.txt
+//	littledate batch [flags] [FILE]
+//	littledate dates [flags] [DATE...]
+//
+// FROM and TO are RFC 3339 timestamps ("2023-01-01T09:00:00Z"), local date-times
+// ("2023-01-01T09:00") or plain dates ("2023-01-12"). A plain date used as the end
+// of a range includes the whole day. INTERVAL is an ISO 8601 interval such as
+// "2023-01-01/2023-01-12", "2023-01-01T09:00/PT2H" or "P1W/2023-03-26".
+// Without arguments, ranges are read from standard input, one per line. With
+// -iso, ranges are written as abbreviated ISO 8601 intervals instead, and with
+// -label as spelled-out labels for screen readers.
+//
+// The batch subcommand adds a formatted range column to a CSV or JSON Lines
+// file; run "littledate batch -h" for its flags. The dates subcommand
+// collapses a set of days into compact runs such as "Jan 1 - 3, 5, 7 - 9".
+//
+// Example:
+//
+//	$ littledate 2023-01-01 2023-01-12 --locale fr --today 2023-11-15
+//	janv. 1 - 12
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	littledate "github.com/hnq90/little-date-go"
+	"github.com/hnq90/little-date-go/internal/timeparse"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// config holds the parsed command line
+type config struct {
+	options  littledate.DateRangeFormatOptions
+	location *time.Location
+	iso      bool
+	label    bool
+	args     []string
+}
+
+// run executes the command and returns the exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) > 0 && args[0] == "batch" {
+		return runBatch(args[1:], stdin, stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == "dates" {
+		return runDates(args[1:], stdin, stdout, stderr)
+	}
+
+	fs := flag.NewFlagSet("littledate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: littledate [flags] FROM TO | INTERVAL")
+		fmt.Fprintln(fs.Output(), "Without arguments, ranges are read from standard input, one per line.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	flags := addFormatFlags(fs)
+	iso := fs.Bool("iso", false, "write abbreviated ISO 8601 intervals, e.g. \"2023-01-01/12\"")
+	label := fs.Bool("label", false, "write spelled-out labels for screen readers, e.g. \"January 1 to January 12, 2023\"")
+
+	cfg, err := parseFlags(fs, flags, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, "littledate:", err)
+		return 2
+	}
+	if *iso && *label {
+		fmt.Fprintln(stderr, "littledate: -iso and -label cannot be combined")
+		return 2
+	}
+	cfg.iso = *iso
+	cfg.label = *label
+
+	switch len(cfg.args) {
+	case 0:
+		return formatLines(cfg, stdin, stdout, stderr)
+	case 1, 2:
+		result, err := formatArgs(cfg, cfg.args)
+		if err != nil {
+			fmt.Fprintln(stderr, "littledate:", err)
+			return 1
+		}
+		fmt.Fprintln(stdout, result)
+		return 0
+	default:
+		fmt.Fprintln(stderr, "littledate: too many arguments")
+		return 2
+	}
+}
+
+// formatFlags are the flags for the littledate.DateRangeFormatOptions fields, shared by all subcommands
+type formatFlags struct {
+	locale      *string
+	includeTime *bool
+	separator   *string
+	today       *string
+	tz          *string
+	bridge      *bool
+	calendar    *string
+	annotation  *string
+	numbering   *string
+	bidi        *string
+}
+
+func addFormatFlags(fs *flag.FlagSet) *formatFlags {
+	return &formatFlags{
+		locale:      fs.String("locale", "", "locale to format in, e.g. \"en_US\", \"fr\" or \"zh-Hant-HK\" (default \"en_US\")"),
+		includeTime: fs.Bool("time", false, "include the time of day in the output"),
+		separator:   fs.String("separator", "", "separator between the start and end of the range (default \"-\")"),
+		today:       fs.String("today", "", "reference date for relative output, e.g. \"2023-11-15\" (default the current date)"),
+		tz:          fs.String("tz", "", "IANA time zone for input without offset and for the output, e.g. \"Asia/Tokyo\" (default local time)"),
+		bridge:      fs.Bool("bridge-weekends", false, "treat days only separated by a weekend as consecutive when collapsing dates"),
+		calendar:    fs.String("calendar", "", "calendar system: \"gregorian\", \"buddhist\", \"japanese\", \"japanese-short\", \"islamic-civil\", \"islamic-umalqura\", \"persian\", \"hebrew\", \"chinese\" or \"vietnamese\" (default \"gregorian\")"),
+		annotation:  fs.String("annotation", "", "calendar to also show the days in, in parentheses, e.g. \"vietnamese\" (default none)"),
+		numbering:   fs.String("numbering", "", "CLDR numbering system for digits, e.g. \"latn\", \"arab\", \"arabext\" or \"hanidec\" (default that of the locale)"),
+		bidi:        fs.String("bidi", "", "protection of numbers for right-to-left display: \"none\", \"isolate\" or \"marks\" (default \"none\")"),
+	}
+}
+
+// parseFlags parses the flags, which may appear before, between or after the positional arguments
+func parseFlags(fs *flag.FlagSet, flags *formatFlags, args []string) (*config, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+
+	cfg := &config{
+		location: time.Local,
+		args:     positional,
+		options: littledate.DateRangeFormatOptions{
+			Locale:         *flags.locale,
+			IncludeTime:    *flags.includeTime,
+			Separator:      *flags.separator,
+			BridgeWeekends: *flags.bridge,
+		},
+	}
+
+	if *flags.tz != "" {
+		location, err := time.LoadLocation(*flags.tz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", *flags.tz, err)
+		}
+		cfg.location = location
+		cfg.options.Location = location
+	}
+
+	if *flags.calendar != "" {
+		calendar, err := littledate.ParseCalendar(*flags.calendar)
+		if err != nil {
+			return nil, fmt.Errorf("invalid calendar %q", *flags.calendar)
+		}
+		cfg.options.Calendar = calendar
+	}
+
+	if *flags.annotation != "" {
+		calendar, err := littledate.ParseCalendar(*flags.annotation)
+		if err != nil {
+			return nil, fmt.Errorf("invalid annotation calendar %q", *flags.annotation)
+		}
+		cfg.options.Annotation = calendar
+	}
+
+	if *flags.numbering != "" {
+		system, err := littledate.ParseNumberingSystem(*flags.numbering)
+		if err != nil {
+			return nil, fmt.Errorf("invalid numbering system %q", *flags.numbering)
+		}
+		cfg.options.NumberingSystem = system
+	}
+
+	if *flags.bidi != "" {
+		mode, err := littledate.ParseBidiMode(*flags.bidi)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bidi mode %q", *flags.bidi)
+		}
+		cfg.options.Bidi = mode
+	}
+
+	if *flags.today != "" {
+		t, _, err := timeparse.Parse(*flags.today, cfg.location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -today: %w", err)
+		}
+		cfg.options.Today = t
+	} else {
+		cfg.options.Today = time.Now().In(cfg.location)
+	}
+
+	return cfg, nil
+}
+
+// formatLines formats one range per line of r. Lines that fail to parse are
+// reported on stderr and the remaining lines are still formatted.
+func formatLines(cfg *config, r io.Reader, stdout, stderr io.Writer) int {
+	status := 0
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		result, err := formatArgs(cfg, fields)
+		if err != nil {
+			fmt.Fprintf(stderr, "littledate: line %d: %v\n", line, err)
+			status = 1
+			continue
+		}
+		fmt.Fprintln(stdout, result)
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintln(stderr, "littledate:", err)
+		return 1
+	}
+	return status
+}
+
+// formatArgs formats a range given either as FROM TO or as a single interval
+func formatArgs(cfg *config, args []string) (string, error) {
+	var r littledate.DateRange
+	var err error
+	switch len(args) {
+	case 1:
+		r, err = littledate.ParseInterval(args[0], cfg.location)
+		if inner := errors.Unwrap(err); inner != nil {
+			// Drop the package prefix, errors are printed after the command name
+			err = inner
+		}
+	case 2:
+		r.From, r.To, err = timeparse.ParseRange(args[0], args[1], cfg.location)
+	default:
+		return "", fmt.Errorf("expected FROM TO or an interval, got %d values", len(args))
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if cfg.iso {
+		return littledate.FormatISOInterval(r.From, r.To, cfg.options), nil
+	}
+	if cfg.label {
+		return r.AccessibleLabel(cfg.options), nil
+	}
+	return r.Format(cfg.options), nil
+}
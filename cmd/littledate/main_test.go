@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		stdin    string
+		expected string
+		status   int
+	}{
+		{
+			name:     "dates with trailing flags",
+			args:     []string{"2023-01-01", "2023-01-12", "--locale", "fr", "--tz", "Asia/Tokyo", "--today", "2023-11-15"},
+			expected: "janv. 1 - 12\n",
+		},
+		{
+			name:     "RFC 3339 with time",
+			args:     []string{"--time", "--tz", "UTC", "--today", "2023-11-15", "2023-01-01T00:11:00Z", "2023-01-01T14:30:00Z"},
+			expected: "Jan 1, 12:11am - 2:30pm\n",
+		},
+		{
+			name:     "RFC 3339 converted to the time zone",
+			args:     []string{"--time", "--tz", "Asia/Tokyo", "--today", "2023-11-15", "2023-01-01T00:00:00Z", "2023-01-01T05:00:00Z"},
+			expected: "Jan 1, 9am - 2pm\n",
+		},
+		{
+			name:     "local date-times",
+			args:     []string{"--time", "--today", "2023-11-15", "--tz", "Europe/Paris", "--locale", "en_GB", "2023-01-01T09:00", "2023-01-01T17:30"},
+			expected: "Jan 1, 9:00 - 17:30\n",
+		},
+		{
+			name:     "interval with separator",
+			args:     []string{"--separator", "to", "--today", "2023-11-15", "2022-01-01/2022-01-12"},
+			expected: "Jan 1 to 12, 2022\n",
+		},
+		{
+			name:     "interval with duration",
+			args:     []string{"--time", "--tz", "UTC", "--today", "2023-11-15", "2023-01-01T09:00/PT2H"},
+			expected: "Jan 1, 9am - 11am\n",
+		},
+		{
+			name:     "interval ending with a week",
+			args:     []string{"--today", "2023-11-15", "P1W/2023-03-26"},
+			expected: "Mar 20 - 26\n",
+		},
+		{
+			name:     "Japanese era",
+			args:     []string{"--calendar", "japanese", "--today", "2023-11-15", "2019-04-29", "2019-05-02"},
+			expected: "平成31年4月29日～令和元年5月2日\n",
+		},
+		{
+			name:     "lunar annotation",
+			args:     []string{"--annotation", "chinese", "--today", "2023-11-15", "2023-01-22", "2023-01-26"},
+			expected: "Jan 22 - 26 (Mo1 1 - 5)\n",
+		},
+		{
+			name:     "numbering system",
+			args:     []string{"--numbering", "arab", "--today", "2023-11-15", "2023-01-01", "2023-01-12"},
+			expected: "Jan ١ - ١٢\n",
+		},
+		{
+			name:     "bidi isolates",
+			args:     []string{"--locale", "ur", "--bidi", "isolate", "--today", "2023-11-15", "2023-01-01", "2023-01-12"},
+			expected: "\u20681\u2069 - \u206812\u2069 جنوری\n",
+		},
+		{
+			name:     "accessible label",
+			args:     []string{"--label", "--today", "2023-11-15", "2023-01-01", "2023-01-12"},
+			expected: "January 1 to January 12, 2023\n",
+		},
+		{
+			name:     "ISO output",
+			args:     []string{"--iso", "--time", "--tz", "UTC", "2023-01-01T09:00", "2023-01-01T11:00"},
+			expected: "2023-01-01T09:00/11:00\n",
+		},
+		{
+			name:     "ISO output from stdin",
+			args:     []string{"--iso"},
+			stdin:    "2023-01-01/2023-01-12\n2023-01-01/P3M\n",
+			expected: "2023-01-01/12\n2023-01/03\n",
+		},
+		{
+			name:     "ranges from stdin",
+			args:     []string{"--today", "2023-11-15"},
+			stdin:    "2023-01-01 2023-01-31\n\n2023-01-01/2023-03-31\n",
+			expected: "January 2023\nQ1 2023\n",
+		},
+		{
+			name:     "invalid lines do not stop stdin processing",
+			args:     []string{"--today", "2023-11-15"},
+			stdin:    "not-a-date 2023-01-31\n2023-01-01 2023-12-31\n",
+			expected: "2023\n",
+			status:   1,
+		},
+		{
+			name:     "dates",
+			args:     []string{"dates", "--today", "2023-11-15", "2023-01-09", "2023-01-01", "2023-01-02", "2023-01-05"},
+			expected: "Jan 1 - 2, 5, 9\n",
+		},
+		{
+			name:     "dates from stdin with bridged weekends",
+			args:     []string{"dates", "--today", "2023-11-15", "--bridge-weekends"},
+			stdin:    "2023-01-05 2023-01-06\n2023-01-09\n",
+			expected: "Jan 5 - 9\n",
+		},
+		{
+			name:     "batch CSV from stdin",
+			args:     []string{"batch", "--today", "2023-11-15", "--tz", "UTC", "--start", "from", "--end", "to"},
+			stdin:    "id,from,to\n1,2023-01-01,2023-01-12\n2,x,2023-01-12\n",
+			expected: "id,from,to,range\n1,2023-01-01,2023-01-12,Jan 1 - 12\n2,x,2023-01-12,\n",
+			status:   1,
+		},
+		{
+			name:     "batch JSON Lines with per-row locale",
+			args:     []string{"batch", "--format", "jsonl", "--locale-column", "lang", "--today", "2023-11-15", "--tz", "UTC"},
+			stdin:    `{"start":"2023-01-01","end":"2023-01-31","lang":"de"}` + "\n",
+			expected: `{"start":"2023-01-01","end":"2023-01-31","lang":"de","range":"Januar 2023"}` + "\n",
+		},
+		{
+			name:   "batch with unknown format",
+			args:   []string{"batch", "--format", "xml"},
+			status: 2,
+		},
+		{
+			name:   "invalid time zone",
+			args:   []string{"--tz", "Mars/Olympus", "2023-01-01", "2023-01-12"},
+			status: 2,
+		},
+		{
+			name:   "end before start",
+			args:   []string{"2023-01-12", "2023-01-01"},
+			status: 1,
+		},
+		{
+			name:   "too many arguments",
+			args:   []string{"2023-01-01", "2023-01-12", "2023-01-13"},
+			status: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			status := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if status != tt.status {
+				t.Errorf("run() = %d, want %d (stderr: %s)", status, tt.status, stderr.String())
+			}
+			if stdout.String() != tt.expected {
+				t.Errorf("run() output = %q, want %q", stdout.String(), tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package littledate
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hnq90/little-date-go/internal/timeparse"
+)
+
+// DateRange is a range of time from From to To, both inclusive.
+//
+// It encodes to JSON as an object with the start, end and a formatted label:
+//
+//	{"start":"2023-01-01T00:00:00Z","end":"2023-01-12T23:59:59.999999999Z","label":"Jan 1 - 12"}
+//
+// and to text and SQL as an ISO 8601 interval:
+//
+//	2023-01-01T00:00:00Z/2023-01-12T23:59:59.999999999Z
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// Format formats the range, see FormatDateRange.
+func (r DateRange) Format(options DateRangeFormatOptions) string {
+	return FormatDateRange(r.From, r.To, options)
+}
+
+// AccessibleLabel describes the range in words, see AccessibleLabel.
+func (r DateRange) AccessibleLabel(options DateRangeFormatOptions) string {
+	return AccessibleLabel(r.From, r.To, options)
+}
+
+// String formats the range with the default options.
+func (r DateRange) String() string {
+	return r.Format(DateRangeFormatOptions{})
+}
+
+// dateRangeJSON is the JSON encoding of a DateRange
+type dateRangeJSON struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+	Label string `json:"label,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. The label is formatted with the
+// default options, relative to the current date.
+func (r DateRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dateRangeJSON{
+		Start: r.From.Format(time.RFC3339Nano),
+		End:   r.To.Format(time.RFC3339Nano),
+		Label: r.String(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts an object with start
+// and end, whose label is optional and ignored, or an ISO 8601 interval string.
+// Values without a UTC offset are interpreted as UTC, and a plain end date
+// includes the whole day.
+func (r *DateRange) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var interval string
+	if err := json.Unmarshal(data, &interval); err == nil {
+		return r.UnmarshalText([]byte(interval))
+	}
+
+	var value dateRangeJSON
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("littledate: invalid date range: %w", err)
+	}
+	if value.Start == "" || value.End == "" {
+		return errors.New("littledate: invalid date range: start and end are required")
+	}
+	from, to, err := timeparse.ParseRange(value.Start, value.End, time.UTC)
+	if err != nil {
+		return fmt.Errorf("littledate: invalid date range: %w", err)
+	}
+	r.From, r.To = from, to
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the range as an ISO 8601 interval.
+func (r DateRange) MarshalText() ([]byte, error) {
+	b := make([]byte, 0, 2*len(time.RFC3339Nano)+1)
+	b = r.From.AppendFormat(b, time.RFC3339Nano)
+	b = append(b, '/')
+	return r.To.AppendFormat(b, time.RFC3339Nano), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding an ISO 8601
+// interval in any of the forms accepted by ParseInterval.
+func (r *DateRange) UnmarshalText(text []byte) error {
+	parsed, err := ParseInterval(string(text), time.UTC)
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, storing the range as an ISO 8601 interval.
+func (r DateRange) Value() (driver.Value, error) {
+	text, err := r.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan implements sql.Scanner, reading an ISO 8601 interval. A NULL value
+// results in the zero DateRange.
+func (r *DateRange) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*r = DateRange{}
+		return nil
+	case string:
+		return r.UnmarshalText([]byte(src))
+	case []byte:
+		return r.UnmarshalText(src)
+	default:
+		return fmt.Errorf("littledate: cannot scan %T into DateRange", src)
+	}
+}
+
+// The algebra below treats ranges as closed intervals with nanosecond
+// precision. A range ending at the last nanosecond of a day, as returned by
+// the end-of-day helpers and by plain end dates in ParseRange, touches a range
+// starting at the following midnight, so the two merge into one and removing
+// one from the other leaves whole days.
+
+// Contains reports whether t lies within the range
+func (r DateRange) Contains(t time.Time) bool {
+	return !t.Before(r.From) && !t.After(r.To)
+}
+
+// Overlaps reports whether the two ranges share at least one instant
+func (r DateRange) Overlaps(other DateRange) bool {
+	return !r.From.After(other.To) && !other.From.After(r.To)
+}
+
+// Intersect returns the instants that lie in both ranges. The result is
+// false if the ranges do not overlap.
+func (r DateRange) Intersect(other DateRange) (DateRange, bool) {
+	if !r.Overlaps(other) {
+		return DateRange{}, false
+	}
+	result := r
+	if other.From.After(result.From) {
+		result.From = other.From
+	}
+	if other.To.Before(result.To) {
+		result.To = other.To
+	}
+	return result, true
+}
+
+// Subtract returns the parts of the range that do not lie in other: none,
+// one or two ranges.
+func (r DateRange) Subtract(other DateRange) []DateRange {
+	if !r.Overlaps(other) {
+		return []DateRange{r}
+	}
+	var result []DateRange
+	if other.From.After(r.From) {
+		result = append(result, DateRange{From: r.From, To: other.From.Add(-time.Nanosecond)})
+	}
+	if other.To.Before(r.To) {
+		result = append(result, DateRange{From: other.To.Add(time.Nanosecond), To: r.To})
+	}
+	return result
+}
+
+// touches reports whether other starts no later than directly after r ends
+func (r DateRange) touches(other DateRange) bool {
+	return !other.From.After(r.To.Add(time.Nanosecond))
+}
+
+// Union merges overlapping and adjacent ranges. The result is sorted by start.
+func Union(ranges ...DateRange) []DateRange {
+	sorted := make([]DateRange, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.To.Before(r.From) {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].From.Before(sorted[j].From)
+	})
+
+	var result []DateRange
+	for _, r := range sorted {
+		if n := len(result); n > 0 && result[n-1].touches(r) {
+			if r.To.After(result[n-1].To) {
+				result[n-1].To = r.To
+			}
+			continue
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+// Intersection returns the instants that lie in both sets of ranges, sorted by start
+func Intersection(a, b []DateRange) []DateRange {
+	a, b = Union(a...), Union(b...)
+
+	var result []DateRange
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		if r, ok := a[i].Intersect(b[j]); ok {
+			result = append(result, r)
+		}
+		if a[i].To.Before(b[j].To) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// Difference returns the instants of a that do not lie in any range of b, sorted by start
+func Difference(a, b []DateRange) []DateRange {
+	result := Union(a...)
+	for _, remove := range Union(b...) {
+		var remaining []DateRange
+		for _, r := range result {
+			remaining = append(remaining, r.Subtract(remove)...)
+		}
+		result = remaining
+	}
+	return result
+}
+
+// SplitUnit is a calendar unit that a range can be split by
+type SplitUnit int
+
+const (
+	// SplitDay splits at midnight
+	SplitDay SplitUnit = iota
+	// SplitWeek splits at midnight on Monday, following ISO 8601
+	SplitWeek
+	// SplitMonth splits at midnight on the first of the month
+	SplitMonth
+)
+
+// Split divides the range at the boundaries of the given unit in the
+// location of From. The first and last parts are cut to the range, the
+// others cover the whole unit and format as such, e.g. "January 2023".
+func (r DateRange) Split(unit SplitUnit) []DateRange {
+	var result []DateRange
+	for from := r.From; !from.After(r.To); {
+		next := nextBoundary(from, unit)
+		to := next.Add(-time.Nanosecond)
+		if to.After(r.To) {
+			to = r.To
+		}
+		result = append(result, DateRange{From: from, To: to})
+		from = next
+	}
+	return result
+}
+
+// nextBoundary returns the start of the unit following the one containing t
+func nextBoundary(t time.Time, unit SplitUnit) time.Time {
+	day := startOfDay(t)
+	switch unit {
+	case SplitWeek:
+		daysFromMonday := (int(day.Weekday()) + 6) % 7
+		return time.Date(day.Year(), day.Month(), day.Day()+7-daysFromMonday, 0, 0, 0, 0, day.Location())
+	case SplitMonth:
+		return time.Date(day.Year(), day.Month()+1, 1, 0, 0, 0, 0, day.Location())
+	default:
+		return time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, day.Location())
+	}
+}
+
+// FormatEach formats every range with the same options, for example the
+// result of Union or Split.
+func FormatEach(ranges []DateRange, options DateRangeFormatOptions) []string {
+	result := make([]string, len(ranges))
+	for i, r := range ranges {
+		result[i] = r.Format(options)
+	}
+	return result
+}
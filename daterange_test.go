@@ -0,0 +1,233 @@
+package littledate
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	_ json.Marshaler           = DateRange{}
+	_ json.Unmarshaler         = (*DateRange)(nil)
+	_ encoding.TextMarshaler   = DateRange{}
+	_ encoding.TextUnmarshaler = (*DateRange)(nil)
+	_ driver.Valuer            = DateRange{}
+	_ sql.Scanner              = (*DateRange)(nil)
+)
+
+var testRange = DateRange{
+	From: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	To:   time.Date(2023, 1, 12, 23, 59, 59, 999999999, time.UTC),
+}
+
+func TestDateRangeFormat(t *testing.T) {
+	if result := testRange.Format(defaultOptions); result != "Jan 1 - 12" {
+		t.Errorf("Format() = %v, want Jan 1 - 12", result)
+	}
+	if result := testRange.String(); result != FormatDateRange(testRange.From, testRange.To, DateRangeFormatOptions{}) {
+		t.Errorf("String() = %v, want the default format", result)
+	}
+}
+
+func TestDateRangeJSON(t *testing.T) {
+	data, err := json.Marshal(testRange)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	expected := `{"start":"2023-01-01T00:00:00Z","end":"2023-01-12T23:59:59.999999999Z","label":"` + testRange.String() + `"}`
+	if string(data) != expected {
+		t.Errorf("Marshal() = %s, want %s", data, expected)
+	}
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "round trip", data: string(data)},
+		{name: "without label", data: `{"start":"2023-01-01T00:00:00Z","end":"2023-01-12T23:59:59.999999999Z"}`},
+		{name: "plain dates", data: `{"start":"2023-01-01","end":"2023-01-12"}`},
+		{name: "interval", data: `"2023-01-01/2023-01-12"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r DateRange
+			if err := json.Unmarshal([]byte(tt.data), &r); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !r.From.Equal(testRange.From) || !r.To.Equal(testRange.To) {
+				t.Errorf("Unmarshal() = %v, want %v", r, testRange)
+			}
+		})
+	}
+
+	for _, data := range []string{`{"start":"2023-01-01"}`, `"2023-01-01"`, `42`} {
+		var r DateRange
+		if err := json.Unmarshal([]byte(data), &r); err == nil {
+			t.Errorf("Unmarshal(%s) error = nil, want an error", data)
+		}
+	}
+}
+
+func TestDateRangeText(t *testing.T) {
+	text, err := testRange.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+	expected := "2023-01-01T00:00:00Z/2023-01-12T23:59:59.999999999Z"
+	if string(text) != expected {
+		t.Errorf("MarshalText() = %s, want %s", text, expected)
+	}
+
+	var r DateRange
+	if err := r.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if !r.From.Equal(testRange.From) || !r.To.Equal(testRange.To) {
+		t.Errorf("UnmarshalText() = %v, want %v", r, testRange)
+	}
+}
+
+func TestDateRangeSQL(t *testing.T) {
+	value, err := testRange.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+
+	for _, src := range []interface{}{value, []byte(value.(string))} {
+		var r DateRange
+		if err := r.Scan(src); err != nil {
+			t.Fatalf("Scan(%T) error = %v", src, err)
+		}
+		if !r.From.Equal(testRange.From) || !r.To.Equal(testRange.To) {
+			t.Errorf("Scan(%T) = %v, want %v", src, r, testRange)
+		}
+	}
+
+	r := testRange
+	if err := r.Scan(nil); err != nil || !r.From.IsZero() || !r.To.IsZero() {
+		t.Errorf("Scan(nil) = %v, %v, want the zero range", r, err)
+	}
+	if err := r.Scan(42); err == nil {
+		t.Errorf("Scan(42) error = nil, want an error")
+	}
+}
+
+// days returns the range covering whole days from the first to the last day of January 2023
+func days(first, last int) DateRange {
+	return DateRange{
+		From: time.Date(2023, 1, first, 0, 0, 0, 0, time.UTC),
+		To:   endOfDay(time.Date(2023, 1, last, 0, 0, 0, 0, time.UTC)),
+	}
+}
+
+func TestDateRangeOverlaps(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     DateRange
+		expected bool
+	}{
+		{name: "overlapping", a: days(1, 10), b: days(5, 15), expected: true},
+		{name: "contained", a: days(1, 10), b: days(3, 4), expected: true},
+		{name: "same last day", a: days(1, 10), b: days(10, 12), expected: true},
+		{name: "adjacent days", a: days(1, 10), b: days(11, 12), expected: false},
+		{name: "disjoint", a: days(1, 3), b: days(5, 7), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.a.Overlaps(tt.b); result != tt.expected {
+				t.Errorf("Overlaps() = %v, want %v", result, tt.expected)
+			}
+			if result := tt.b.Overlaps(tt.a); result != tt.expected {
+				t.Errorf("Overlaps() reversed = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRangeAlgebra(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   []DateRange
+		expected []string
+	}{
+		{
+			name:     "union merges overlapping and adjacent days",
+			result:   Union(days(20, 25), days(1, 10), days(11, 12), days(5, 8)),
+			expected: []string{"Jan 1 - 12", "Jan 20 - 25"},
+		},
+		{
+			name:     "union of whole months",
+			result:   Union(days(1, 31), DateRange{From: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), To: endOfMonth(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC))}),
+			expected: []string{"Jan - Feb 2023"},
+		},
+		{
+			name:     "intersection",
+			result:   Intersection([]DateRange{days(1, 10), days(20, 31)}, []DateRange{days(5, 25)}),
+			expected: []string{"Jan 5 - 10", "Jan 20 - 25"},
+		},
+		{
+			name:     "difference leaves whole days",
+			result:   Difference([]DateRange{days(1, 31)}, []DateRange{days(10, 12), days(20, 20)}),
+			expected: []string{"Jan 1 - 9", "Jan 13 - 19", "Jan 21 - 31"},
+		},
+		{
+			name:     "difference removing everything",
+			result:   Difference([]DateRange{days(3, 4)}, []DateRange{days(1, 10)}),
+			expected: []string{},
+		},
+		{
+			name:     "split by day",
+			result:   days(1, 3).Split(SplitDay),
+			expected: []string{"Sun, Jan 1", "Mon, Jan 2", "Tue, Jan 3"},
+		},
+		{
+			name:     "split by week",
+			result:   days(1, 20).Split(SplitWeek),
+			expected: []string{"Sun, Jan 1", "Jan 2 - 8", "Jan 9 - 15", "Jan 16 - 20"},
+		},
+		{
+			name: "split by month",
+			result: DateRange{
+				From: time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC),
+				To:   endOfDay(time.Date(2023, 3, 10, 0, 0, 0, 0, time.UTC)),
+			}.Split(SplitMonth),
+			expected: []string{"Jan 15 - 31", "February 2023", "Mar 1 - 10"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatEach(tt.result, defaultOptions)
+			if strings.Join(result, "; ") != strings.Join(tt.expected, "; ") {
+				t.Errorf("result = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSplitAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone data not available")
+	}
+	r := DateRange{
+		From: time.Date(2023, 3, 25, 0, 0, 0, 0, loc),
+		To:   endOfDay(time.Date(2023, 3, 27, 0, 0, 0, 0, loc)),
+	}
+
+	parts := r.Split(SplitDay)
+	if len(parts) != 3 {
+		t.Fatalf("Split() = %d parts, want 3", len(parts))
+	}
+	for i, part := range parts {
+		if !part.From.Equal(startOfDay(part.From)) || !part.To.Equal(endOfDay(part.From)) {
+			t.Errorf("Split()[%d] = %v - %v, want a whole day", i, part.From, part.To)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package littledate
+
+import (
+	"sort"
+	"time"
+)
+
+// FormatDates formats a set of days compactly. The days are sorted, repeated
+// days are dropped and consecutive days are grouped into runs. The month is
+// only repeated when it changes and the year is printed after the last day of
+// each year that is not the current one. The time of day is ignored.
+// Months and years are those of options.Calendar, written in the layout of
+// FormatDateRange.
+//
+// Examples:
+// - Jan 1 - 3, 5, 7 - 9
+// - Jan 30 - Feb 2, 6
+// - Dec 30 - 31, 2022, Jan 2
+func FormatDates(days []time.Time, options DateRangeFormatOptions) string {
+	setDefaults(&options)
+	runs := dayRuns(days, options.Location, options.BridgeWeekends)
+	if len(runs) == 0 {
+		return ""
+	}
+
+	locale := localeOf(options)
+	cal := calendarOf(options)
+	todayYear, _, _ := cal.Date(options.Today)
+	items := make([]string, len(runs))
+	var buf [64]byte
+	for i, run := range runs {
+		b := buf[:0]
+		if w, ok := cal.(rangeWriter); ok {
+			// Calendars with a layout of their own write each run in full
+			items[i] = string(w.appendRange(b, run.From, endOfDay(run.To), options, locale))
+			continue
+		}
+		fromYear, fromMonth, fromDay := cal.Date(run.From)
+		toYear, toMonth, toDay := cal.Date(run.To)
+		single := run.To.Equal(run.From)
+		sameMonth := fromYear == toYear && fromMonth == toMonth
+
+		// The month is left out if the previous run ended in the same month.
+		// Right-to-left locales put the day first, so there the month is left
+		// out if the next run starts in the same month, e.g. "١ - ٣، ٥ يناير".
+		monthFollows := locale.rtl && i < len(runs)-1 && sameCalendarMonth(cal, run.To, runs[i+1].From)
+		switch {
+		case locale.rtl && (single && monthFollows || sameMonth && !single):
+			b = appendNumber(b, fromDay, locale.digits)
+		case !locale.rtl && i > 0 && sameCalendarMonth(cal, runs[i-1].To, run.From):
+			b = appendNumber(b, fromDay, locale.digits)
+		default:
+			b = appendMonthDay(b, cal, locale, run.From)
+		}
+
+		if !single {
+			if fromYear != toYear {
+				b = appendYearSuffix(b, locale, fromYear, fromYear == todayYear)
+			}
+			b = append(b, ' ')
+			b = append(b, options.Separator...)
+			b = append(b, ' ')
+			if sameMonth && !locale.rtl || monthFollows {
+				b = appendNumber(b, toDay, locale.digits)
+			} else {
+				b = appendMonthDay(b, cal, locale, run.To)
+			}
+		}
+
+		// The year follows the last day of each year
+		if i == len(runs)-1 {
+			b = appendYearSuffix(b, locale, toYear, toYear == todayYear)
+		} else if nextYear, _, _ := cal.Date(runs[i+1].From); nextYear != toYear {
+			b = appendYearSuffix(b, locale, toYear, toYear == todayYear)
+		}
+		items[i] = string(b)
+	}
+
+	result := items[0]
+	for _, item := range items[1:] {
+		result = locale.localize("list.middle", -1, map[string]interface{}{
+			"First":  result,
+			"Second": item,
+		})
+	}
+	return protectNumbersString(result, options)
+}
+
+// FormatDates formats a set of days compactly, see FormatDates.
+func (f Formatter) FormatDates(days []time.Time) string {
+	return FormatDates(days, f.Options)
+}
+
+// dayRuns sorts and deduplicates the days and groups consecutive days into
+// ranges from the start of the first to the start of the last day
+func dayRuns(days []time.Time, loc *time.Location, bridgeWeekends bool) []DateRange {
+	sorted := make([]time.Time, len(days))
+	for i, day := range days {
+		if loc != nil {
+			day = day.In(loc)
+		}
+		sorted[i] = startOfDay(day)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Before(sorted[j])
+	})
+
+	var runs []DateRange
+	for _, day := range sorted {
+		if n := len(runs); n > 0 {
+			last := runs[n-1].To
+			if sameDay(last, day) {
+				continue
+			}
+			if followsDay(last, day, bridgeWeekends) {
+				runs[n-1].To = day
+				continue
+			}
+		}
+		runs = append(runs, DateRange{From: day, To: day})
+	}
+	return runs
+}
+
+// followsDay reports whether next is the day after last, or, if weekends are
+// bridged, whether only weekend days lie between them
+func followsDay(last, next time.Time, bridgeWeekends bool) bool {
+	day := nextDay(last)
+	for bridgeWeekends && !sameDay(day, next) && isWeekend(day) {
+		day = nextDay(day)
+	}
+	return sameDay(day, next)
+}
+
+func nextDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
+
+func sameDay(t1, t2 time.Time) bool {
+	y1, m1, d1 := t1.Date()
+	y2, m2, d2 := t2.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+// sameCalendarMonth reports whether two times lie in the same month of a calendar
+func sameCalendarMonth(cal Calendar, t1, t2 time.Time) bool {
+	y1, m1, _ := cal.Date(t1)
+	y2, m2, _ := cal.Date(t2)
+	return y1 == y2 && m1 == m2
+}
@@ -0,0 +1,86 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDates(t *testing.T) {
+	day := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 9, 30, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		days     []time.Time
+		options  DateRangeFormatOptions
+		expected string
+	}{
+		{name: "empty", days: nil, expected: ""},
+		{name: "single day", days: []time.Time{day(2023, 1, 5)}, expected: "Jan 5"},
+		{
+			name:     "runs in one month",
+			days:     []time.Time{day(2023, 1, 9), day(2023, 1, 1), day(2023, 1, 3), day(2023, 1, 2), day(2023, 1, 5), day(2023, 1, 7), day(2023, 1, 8)},
+			expected: "Jan 1 - 3, 5, 7 - 9",
+		},
+		{
+			name:     "duplicates",
+			days:     []time.Time{day(2023, 1, 1), day(2023, 1, 1), time.Date(2023, 1, 1, 18, 0, 0, 0, time.UTC), day(2023, 1, 2)},
+			expected: "Jan 1 - 2",
+		},
+		{
+			name:     "across months",
+			days:     []time.Time{day(2023, 1, 30), day(2023, 1, 31), day(2023, 2, 1), day(2023, 2, 2), day(2023, 2, 6), day(2023, 3, 1)},
+			expected: "Jan 30 - Feb 2, 6, Mar 1",
+		},
+		{
+			name:     "previous year",
+			days:     []time.Time{day(2022, 3, 1), day(2022, 3, 2), day(2022, 3, 4)},
+			expected: "Mar 1 - 2, 4, 2022",
+		},
+		{
+			name:     "across years",
+			days:     []time.Time{day(2022, 12, 28), day(2022, 12, 30), day(2022, 12, 31), day(2023, 1, 1), day(2023, 1, 3)},
+			expected: "Dec 28, 30, 2022 - Jan 1, 3",
+		},
+		{
+			name:     "weekend not bridged",
+			days:     []time.Time{day(2023, 1, 5), day(2023, 1, 6), day(2023, 1, 9), day(2023, 1, 10)},
+			expected: "Jan 5 - 6, 9 - 10",
+		},
+		{
+			name:     "weekend bridged",
+			days:     []time.Time{day(2023, 1, 5), day(2023, 1, 6), day(2023, 1, 9), day(2023, 1, 10)},
+			options:  DateRangeFormatOptions{BridgeWeekends: true},
+			expected: "Jan 5 - 10",
+		},
+		{
+			name:     "weekday gap not bridged",
+			days:     []time.Time{day(2023, 1, 6), day(2023, 1, 10)},
+			options:  DateRangeFormatOptions{BridgeWeekends: true},
+			expected: "Jan 6, 10",
+		},
+		{
+			name:     "location",
+			days:     []time.Time{time.Date(2023, 1, 1, 23, 0, 0, 0, time.UTC), day(2023, 1, 3)},
+			options:  DateRangeFormatOptions{Location: time.FixedZone("UTC+2", 2*60*60)},
+			expected: "Jan 2 - 3",
+		},
+		{
+			name:     "ja",
+			days:     []time.Time{day(2023, 1, 1), day(2023, 1, 2), day(2023, 1, 5)},
+			options:  DateRangeFormatOptions{Locale: "ja"},
+			expected: "1月 1 - 2、5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := tt.options
+			options.Today = today
+			if result := FormatDates(tt.days, options); result != tt.expected {
+				t.Errorf("FormatDates() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package littledate
+
+import (
+	"time"
+)
+
+// Formatter formats dates, ranges and durations with a fixed set of options.
+// Its zero value uses the defaults of DateRangeFormatOptions.
+type Formatter struct {
+	Options DateRangeFormatOptions
+}
+
+// FormatDateRange formats a date range, see FormatDateRange.
+func (f Formatter) FormatDateRange(from, to time.Time) string {
+	return FormatDateRange(from, to, f.Options)
+}
+
+// FormatDate formats a single date, see FormatDate.
+func (f Formatter) FormatDate(date time.Time) string {
+	return FormatDate(date, f.Options)
+}
+
+// FormatRelative formats a date relative to today, see FormatRelative.
+func (f Formatter) FormatRelative(date time.Time) string {
+	return FormatRelative(date, f.Options)
+}
+
+// FormatDuration formats a duration, see FormatDuration.
+func (f Formatter) FormatDuration(d time.Duration) string {
+	return FormatDuration(d, f.Options)
+}
+
+// FormatDate formats a single date in the same style as FormatDateRange.
+// The time is only shown if options.IncludeTime is set and the date is not at midnight.
+//
+// Examples:
+// - Sun, Jan 1
+// - Sat, Jan 1, 2022
+// - Jan 1, 2:30pm
+// - 2:30pm (today)
+func FormatDate(date time.Time, options DateRangeFormatOptions) string {
+	var buf [64]byte
+	return string(protectNumbers(appendDate(buf[:0], date, options), 0, options))
+}
+
+// appendDate renders a single date into b
+func appendDate(b []byte, date time.Time, options DateRangeFormatOptions) []byte {
+	setDefaults(&options)
+	if options.Location != nil {
+		date = date.In(options.Location)
+	}
+
+	locale := localeOf(options)
+	cal := calendarOf(options)
+	if j, ok := cal.(japanese); ok {
+		return j.appendDate(b, date, options, locale)
+	}
+	year, month, day := cal.Date(date)
+	todayYear, todayMonth, todayDay := cal.Date(options.Today)
+	thisYear := year == todayYear
+	thisDay := thisYear && month == todayMonth && day == todayDay
+
+	if options.IncludeTime && !isSameMinute(startOfDay(date), date) {
+		// If it's today, don't include the date
+		if thisDay {
+			return appendTime(b, date, locale.hour24, locale.digits)
+		}
+
+		// Example: Jan 1, 2:30pm[, 2022]
+		b = appendMonthDay(b, cal, locale, date)
+		b = append(b, locale.names.comma...)
+		b = appendTime(b, date, locale.hour24, locale.digits)
+		return appendYearSuffix(b, locale, year, thisYear)
+	}
+
+	// Example: Sun, Jan 1[, 2022]
+	b = append(b, locale.names.shortWeekdays[date.Weekday()]...)
+	b = append(b, locale.names.comma...)
+	b = appendMonthDay(b, cal, locale, date)
+	return appendYearSuffix(b, locale, year, thisYear)
+}
+
+// relativeUnits are the units used by FormatRelative, from largest to smallest,
+// with the length of one unit. Months and years are approximated.
+var relativeUnits = []struct {
+	name   string
+	length time.Duration
+}{
+	{"year", 365 * 24 * time.Hour},
+	{"month", 30 * 24 * time.Hour},
+	{"week", 7 * 24 * time.Hour},
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+}
+
+// FormatRelative formats a date relative to options.Today, using the largest
+// unit that fits and rounding down.
+//
+// Examples:
+// - now
+// - 5 minutes ago
+// - in 3 days
+// - 2 months ago
+func FormatRelative(date time.Time, options DateRangeFormatOptions) string {
+	setDefaults(&options)
+	locale := localeOf(options)
+
+	d := date.Sub(options.Today)
+	direction := "relative.future."
+	if d < 0 {
+		d = -d
+		direction = "relative.past."
+	}
+
+	for _, unit := range relativeUnits {
+		if d >= unit.length {
+			count := int(d / unit.length)
+			return protectNumbersString(locale.localize(direction+unit.name, count, map[string]interface{}{
+				"Count": formatNumber(count, locale.digits),
+			}), options)
+		}
+	}
+	return locale.localize("relative.now", -1, nil)
+}
+
+// durationUnits are the units used by FormatDuration, from largest to smallest
+var durationUnits = []struct {
+	name   string
+	length time.Duration
+}{
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+	{"second", time.Second},
+}
+
+// FormatDuration formats a duration using its largest unit, followed by the
+// next smaller unit if that is not zero. Negative durations are formatted
+// like positive ones and anything below a second is dropped.
+//
+// Examples:
+// - 45 seconds
+// - 2 hours
+// - 1 hour 30 minutes
+// - 3 days 4 hours
+func FormatDuration(d time.Duration, options DateRangeFormatOptions) string {
+	setDefaults(&options)
+	locale := localeOf(options)
+
+	if d < 0 {
+		d = -d
+	}
+
+	formatUnit := func(i int, count int) string {
+		return protectNumbersString(locale.localize("duration."+durationUnits[i].name, count, map[string]interface{}{
+			"Count": formatNumber(count, locale.digits),
+		}), options)
+	}
+
+	for i, unit := range durationUnits {
+		if d < unit.length && i < len(durationUnits)-1 {
+			continue
+		}
+
+		count := int(d / unit.length)
+		first := formatUnit(i, count)
+		if i == len(durationUnits)-1 {
+			return first
+		}
+
+		next := int((d % unit.length) / durationUnits[i+1].length)
+		if next == 0 {
+			return first
+		}
+		return locale.localize("duration.pair", -1, map[string]interface{}{
+			"First":  first,
+			"Second": formatUnit(i+1, next),
+		})
+	}
+	return ""
+}
@@ -0,0 +1,123 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDate(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		options  DateRangeFormatOptions
+		expected string
+	}{
+		{
+			name:     "full day",
+			date:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			options:  defaultOptions,
+			expected: "Sun, Jan 1",
+		},
+		{
+			name:     "full day different year",
+			date:     time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			options:  defaultOptions,
+			expected: "Sat, Jan 1, 2022",
+		},
+		{
+			name:     "with time",
+			date:     time.Date(2023, 1, 1, 14, 30, 0, 0, time.UTC),
+			options:  defaultOptions,
+			expected: "Jan 1, 2:30pm",
+		},
+		{
+			name:     "with time, includeTime: false",
+			date:     time.Date(2023, 1, 1, 14, 30, 0, 0, time.UTC),
+			options:  DateRangeFormatOptions{Today: today},
+			expected: "Sun, Jan 1",
+		},
+		{
+			name:     "today with time",
+			date:     today.Add(time.Hour),
+			options:  defaultOptions,
+			expected: "1pm",
+		},
+		{
+			name:     "converted to location",
+			date:     time.Date(2023, 1, 1, 20, 0, 0, 0, time.UTC),
+			options:  DateRangeFormatOptions{Today: today, Locale: "en_GB", IncludeTime: true, Location: tokyo},
+			expected: "Jan 2, 5:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := FormatDate(tt.date, tt.options); result != tt.expected {
+				t.Errorf("FormatDate() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatRelative(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     time.Time
+		locale   string
+		expected string
+	}{
+		{name: "now", date: today.Add(30 * time.Second), locale: "en", expected: "now"},
+		{name: "one minute ago", date: today.Add(-time.Minute), locale: "en", expected: "1 minute ago"},
+		{name: "in hours", date: today.Add(5*time.Hour + 59*time.Minute), locale: "en", expected: "in 5 hours"},
+		{name: "days ago", date: today.AddDate(0, 0, -3), locale: "en", expected: "3 days ago"},
+		{name: "in weeks", date: today.AddDate(0, 0, 15), locale: "en", expected: "in 2 weeks"},
+		{name: "months ago", date: today.AddDate(0, -2, 0), locale: "en", expected: "2 months ago"},
+		{name: "in a year", date: today.AddDate(1, 1, 0), locale: "en", expected: "in 1 year"},
+		{name: "German past", date: today.AddDate(0, 0, -3), locale: "de", expected: "vor 3 Tagen"},
+		{name: "French future", date: today.Add(time.Hour), locale: "fr", expected: "dans 1 heure"},
+		{name: "Japanese past", date: today.AddDate(0, 0, -3), locale: "ja", expected: "3日前"},
+		{name: "Vietnamese future", date: today.AddDate(0, 0, 3), locale: "vi", expected: "sau 3 ngày"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := DateRangeFormatOptions{Today: today, Locale: tt.locale}
+			if result := FormatRelative(tt.date, options); result != tt.expected {
+				t.Errorf("FormatRelative() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		duration time.Duration
+		locale   string
+		expected string
+	}{
+		{name: "zero", duration: 0, locale: "en", expected: "0 seconds"},
+		{name: "seconds", duration: 45 * time.Second, locale: "en", expected: "45 seconds"},
+		{name: "one hour", duration: time.Hour, locale: "en", expected: "1 hour"},
+		{name: "hour and minutes", duration: 90 * time.Minute, locale: "en", expected: "1 hour 30 minutes"},
+		{name: "negative", duration: -90 * time.Minute, locale: "en", expected: "1 hour 30 minutes"},
+		{name: "days and hours", duration: 76 * time.Hour, locale: "en", expected: "3 days 4 hours"},
+		{name: "smaller units are dropped", duration: 24*time.Hour + 5*time.Minute, locale: "en", expected: "1 day"},
+		{name: "German", duration: 2 * 24 * time.Hour, locale: "de", expected: "2 Tage"},
+		{name: "Chinese", duration: 90 * time.Minute, locale: "zh-CN", expected: "1小时30分钟"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := DateRangeFormatOptions{Today: today, Locale: tt.locale}
+			if result := FormatDuration(tt.duration, options); result != tt.expected {
+				t.Errorf("FormatDuration() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
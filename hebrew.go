@@ -0,0 +1,149 @@
+package littledate
+
+import (
+	"strconv"
+	"time"
+)
+
+// Hebrew is the Hebrew (Jewish) calendar. Years are counted from the
+// creation era and begin with Tishrei. Seven years of every 19 are leap
+// years with a thirteenth month: Adar is then split into Adar I and Adar II.
+// Months are numbered from Tishrei, so in leap years Nisan is month 8.
+//
+// Examples:
+// - Tishrei 5784
+// - Kislev 25 - Tevet 2
+// - Adar I 5784
+var Hebrew Calendar = hebrew{}
+
+// hebrewEpoch is 1 Tishrei 1 AM (October 7, 3761 BC, Julian), in days since January 1, 1970
+const hebrewEpoch = -2092590
+
+// hebrewMonths are the English month names by their position in a leap
+// year, with Adar of common years at the position of Adar II
+var hebrewMonths = [13]string{
+	"Tishrei", "Heshvan", "Kislev", "Tevet", "Shevat", "Adar I", "Adar",
+	"Nisan", "Iyar", "Sivan", "Tamuz", "Av", "Elul",
+}
+
+type hebrew struct{}
+
+func (hebrew) Date(t time.Time) (int, int, int) {
+	days := epochDays(t)
+
+	// Estimate the year from the mean length of a year and correct it
+	year := floorDiv((days-hebrewEpoch)*98496, 35975351) + 1
+	for hebrewNewYear(year+1) <= days {
+		year++
+	}
+	for hebrewNewYear(year) > days {
+		year--
+	}
+
+	day := days - hebrewNewYear(year) + 1
+	month := 1
+	for day > hebrewMonthLength(year, month) {
+		day -= hebrewMonthLength(year, month)
+		month++
+	}
+	return year, month, day
+}
+
+func (hebrew) Time(year, month, day int, loc *time.Location) time.Time {
+	days := hebrewNewYear(year) + day - 1
+	for m := 1; m < month; m++ {
+		days += hebrewMonthLength(year, m)
+	}
+	return fromEpochDays(days, loc)
+}
+
+func (hebrew) MonthsInYear(year int) int {
+	if isHebrewLeapYear(year) {
+		return 13
+	}
+	return 12
+}
+
+func (hebrew) DaysInMonth(year, month int) int {
+	return hebrewMonthLength(year, month)
+}
+
+func (hebrew) monthName(locale *localeData, year, month int, long bool) string {
+	index := hebrewMonthIndex(year, month)
+	key, fallback := strconv.Itoa(index), hebrewMonths[index-1]
+	if index == 7 && isHebrewLeapYear(year) {
+		key, fallback = "7.leap", "Adar II"
+	}
+	return localizedMonthName(locale, "hebrew", key, long, fallback)
+}
+
+// isHebrewLeapYear reports whether a year has 13 months
+func isHebrewLeapYear(year int) bool {
+	return ((7*year+1)%19+19)%19 < 7
+}
+
+// hebrewMonthIndex returns the position of a month in a leap year, so that
+// months with the same name share an index; Adar of common years is 7
+func hebrewMonthIndex(year, month int) int {
+	if month >= 6 && !isHebrewLeapYear(year) {
+		return month + 1
+	}
+	return month
+}
+
+// hebrewMonthLength returns the number of days of a month. Heshvan and
+// Kislev vary so that the next new year does not fall on a forbidden day.
+func hebrewMonthLength(year, month int) int {
+	switch hebrewMonthIndex(year, month) {
+	case 2:
+		if hebrewYearLength(year)%10 == 5 {
+			return 30
+		}
+		return 29
+	case 3:
+		if hebrewYearLength(year)%10 == 3 {
+			return 29
+		}
+		return 30
+	case 1, 5, 6, 8, 10, 12:
+		return 30
+	}
+	return 29
+}
+
+func hebrewYearLength(year int) int {
+	return hebrewNewYear(year+1) - hebrewNewYear(year)
+}
+
+// hebrewNewYear returns the day number of 1 Tishrei of a year
+func hebrewNewYear(year int) int {
+	return hebrewEpoch + hebrewElapsedDays(year) + hebrewNewYearDelay(year)
+}
+
+// hebrewElapsedDays returns the number of days from the epoch to the molad
+// of Tishrei of a year, postponed by a day if the molad is on a Sunday,
+// Wednesday or Friday
+func hebrewElapsedDays(year int) int {
+	months := floorDiv(235*year-234, 19)
+	parts := 12084 + 13753*months
+	days := 29*months + floorDiv(parts, 25920)
+	if (3*(days+1))%7 < 3 {
+		days++
+	}
+	return days
+}
+
+// hebrewNewYearDelay postpones the new year by one or two days when the
+// year would otherwise be 356 or 382 days long
+func hebrewNewYearDelay(year int) int {
+	previous := hebrewElapsedDays(year - 1)
+	current := hebrewElapsedDays(year)
+	next := hebrewElapsedDays(year + 1)
+	switch {
+	case next-current == 356:
+		return 2
+	case current-previous == 382:
+		return 1
+	}
+	return 0
+}
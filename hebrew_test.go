@@ -0,0 +1,104 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHebrewCalendar(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	end := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+	}
+	english := DateRangeFormatOptions{Today: today, Locale: "en_US", Calendar: Hebrew}
+	hebrew := DateRangeFormatOptions{Today: today, Locale: "he", Calendar: Hebrew}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		options  DateRangeFormatOptions
+		expected string
+	}{
+		{"full month", date(2023, 9, 16), end(2023, 10, 15), english, "Tishrei 5784"},
+		{"days", date(2023, 12, 8), end(2023, 12, 15), english, "Kislev 25 - Tevet 3"},
+		{"full year", date(2023, 9, 16), end(2024, 10, 2), english, "5784"},
+		{"Adar I", date(2024, 2, 10), end(2024, 3, 10), english, "Adar I 5784"},
+		{"Adar II", date(2024, 3, 11), end(2024, 4, 8), english, "Adar II 5784"},
+		{"Adar in a common year", date(2023, 2, 22), end(2023, 3, 22), english, "Adar 5783"},
+		{"across Adar I and Adar II", date(2024, 3, 4), end(2024, 3, 15), english, "Adar I 24 - Adar II 5"},
+		{"Adar I and Adar II", date(2024, 2, 10), end(2024, 4, 8), english, "Adar I - Adar II 5784"},
+		{"no quarters in leap years", date(2023, 9, 16), end(2023, 12, 12), english, "Tishrei - Kislev 5784"},
+		{"across years", date(2023, 9, 10), end(2023, 9, 20), english, "Elul 24 '83 - Tishrei 5 '84"},
+		{"hebrew", date(2023, 9, 16), end(2023, 10, 15), hebrew, "תשרי 5784"},
+		{"hebrew Adar II", date(2024, 3, 11), end(2024, 4, 8), hebrew, "אדר ב׳ 5784"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDateRange(tt.from, tt.to, tt.options)
+			if result != tt.expected {
+				t.Errorf("FormatDateRange() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHebrewMonths(t *testing.T) {
+	tests := []struct {
+		name   string
+		year   int
+		months int
+		days   []int
+	}{
+		// A deficient leap year of 383 days: Heshvan and Kislev have 29 days
+		{"5784", 5784, 13, []int{30, 29, 29, 29, 30, 30, 29, 30, 29, 30, 29, 30, 29}},
+		// A complete common year of 355 days: Heshvan and Kislev have 30 days
+		{"5783", 5783, 12, []int{30, 30, 30, 29, 30, 29, 30, 29, 30, 29, 30, 29}},
+		{"5785", 5785, 12, []int{30, 30, 30, 29, 30, 29, 30, 29, 30, 29, 30, 29}},
+		// A complete leap year of 385 days
+		{"5787", 5787, 13, []int{30, 30, 30, 29, 30, 30, 29, 30, 29, 30, 29, 30, 29}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if months := Hebrew.MonthsInYear(tt.year); months != tt.months {
+				t.Fatalf("MonthsInYear() = %v, want %v", months, tt.months)
+			}
+			for month, want := range tt.days {
+				if got := Hebrew.DaysInMonth(tt.year, month+1); got != want {
+					t.Errorf("DaysInMonth(%d, %d) = %v, want %v", tt.year, month+1, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestHebrewConversion(t *testing.T) {
+	tests := []struct {
+		date  time.Time
+		year  int
+		month int
+		day   int
+	}{
+		{time.Date(2023, 9, 16, 0, 0, 0, 0, time.UTC), 5784, 1, 1},
+		{time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), 5784, 7, 1},
+		{time.Date(2024, 4, 23, 0, 0, 0, 0, time.UTC), 5784, 8, 15},
+		{time.Date(2023, 4, 6, 0, 0, 0, 0, time.UTC), 5783, 7, 15},
+		{time.Date(2046, 10, 1, 0, 0, 0, 0, time.UTC), 5807, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date.Format("2006-01-02"), func(t *testing.T) {
+			year, month, day := Hebrew.Date(tt.date)
+			if year != tt.year || month != tt.month || day != tt.day {
+				t.Errorf("Date() = %d-%d-%d, want %d-%d-%d", year, month, day, tt.year, tt.month, tt.day)
+			}
+			if start := Hebrew.Time(year, month, day, time.UTC); !start.Equal(tt.date) {
+				t.Errorf("Time() = %v, want %v", start, tt.date)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Package httpapi serves littledate.FormatDateRange over HTTP, so that
+// services written in other languages get exactly the same output as Go code.
+//
+// A single range is formatted with a GET request:
+//
+//	GET /?from=2023-01-01&to=2023-01-12&locale=fr&tz=Europe/Paris&includeTime=true
+//
+//	{"formatted":"janv. 1 - 12","locale":"fr"}
+//
+// Several ranges are formatted at once with a POST request whose body is a
+// JSON array of ranges. The response holds one result per range, in order;
+// ranges that cannot be formatted have an error instead of a result:
+//
+//	POST /
+//	[{"from":"2023-01-01","to":"2023-01-31","locale":"de"},{"from":"x","to":"y"}]
+//
+//	[{"formatted":"Januar 2023","locale":"de"},{"error":"cannot parse \"x\" as a date or time"}]
+//
+// Times are RFC 3339 timestamps, local date-times or plain dates; a plain
+// end date includes the whole day. When a range has no locale, it is
+// negotiated from the Accept-Language header.
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	littledate "github.com/hnq90/little-date-go"
+	"github.com/hnq90/little-date-go/internal/timeparse"
+	"golang.org/x/text/language"
+)
+
+// Defaults used when Options fields are not set
+const (
+	defaultMaxBatch     = 1000
+	defaultMaxBodyBytes = 1 << 20
+)
+
+// Options configures a Handler.
+type Options struct {
+	// DefaultLocale is used when neither the range nor the Accept-Language
+	// header specify a supported locale. If not specified, "en_US" will be used.
+	DefaultLocale string
+
+	// Location is the time zone used for ranges without a tz.
+	// If not specified, UTC will be used.
+	Location *time.Location
+
+	// Separator is passed to littledate.FormatDateRange for every range.
+	Separator string
+
+	// MaxBatch limits the number of ranges in a POST request.
+	// If not specified, 1000 will be used.
+	MaxBatch int
+
+	// MaxBodyBytes limits the size of the body of a POST request.
+	// If not specified, 1 MiB will be used.
+	MaxBodyBytes int64
+
+	// Now returns the reference date for relative output.
+	// If not specified, time.Now will be used.
+	Now func() time.Time
+}
+
+// Request is a single range to format.
+type Request struct {
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Locale      string `json:"locale,omitempty"`
+	TZ          string `json:"tz,omitempty"`
+	IncludeTime bool   `json:"includeTime,omitempty"`
+}
+
+// Response is the result of formatting a single range.
+type Response struct {
+	// Formatted is the formatted range.
+	Formatted string `json:"formatted,omitempty"`
+
+	// Locale is the translation locale that was used, see littledate.MatchLocale.
+	Locale string `json:"locale,omitempty"`
+
+	// Error describes why the range could not be formatted.
+	Error string `json:"error,omitempty"`
+}
+
+// Handler formats date ranges from GET query parameters or POST JSON batches.
+type Handler struct {
+	options Options
+}
+
+// NewHandler returns a Handler using the given options.
+func NewHandler(options Options) *Handler {
+	if options.DefaultLocale == "" {
+		options.DefaultLocale = "en_US"
+	}
+	if options.Location == nil {
+		options.Location = time.UTC
+	}
+	if options.MaxBatch <= 0 {
+		options.MaxBatch = defaultMaxBatch
+	}
+	if options.MaxBodyBytes <= 0 {
+		options.MaxBodyBytes = defaultMaxBodyBytes
+	}
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+	return &Handler{options: options}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		h.serveGet(w, r)
+	case http.MethodPost:
+		h.servePost(w, r)
+	default:
+		w.Header().Set("Allow", "GET, HEAD, POST")
+		writeJSON(w, http.StatusMethodNotAllowed, Response{Error: "method not allowed"})
+	}
+}
+
+func (h *Handler) serveGet(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	request := Request{
+		From:   query.Get("from"),
+		To:     query.Get("to"),
+		Locale: query.Get("locale"),
+		TZ:     query.Get("tz"),
+	}
+	if value := query.Get("includeTime"); value != "" {
+		includeTime, err := strconv.ParseBool(value)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, Response{Error: fmt.Sprintf("invalid includeTime %q", value)})
+			return
+		}
+		request.IncludeTime = includeTime
+	}
+
+	response := h.format(request, r.Header.Get("Accept-Language"))
+	status := http.StatusOK
+	if response.Error != "" {
+		status = http.StatusBadRequest
+	}
+	writeJSON(w, status, response)
+}
+
+func (h *Handler) servePost(w http.ResponseWriter, r *http.Request) {
+	var requests []Request
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, h.options.MaxBodyBytes))
+	if err := decoder.Decode(&requests); err != nil {
+		status := http.StatusBadRequest
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		writeJSON(w, status, Response{Error: "invalid request body: " + err.Error()})
+		return
+	}
+	if len(requests) > h.options.MaxBatch {
+		writeJSON(w, http.StatusRequestEntityTooLarge, Response{
+			Error: fmt.Sprintf("too many ranges: %d, at most %d are allowed", len(requests), h.options.MaxBatch),
+		})
+		return
+	}
+
+	acceptLanguage := r.Header.Get("Accept-Language")
+	responses := make([]Response, len(requests))
+	for i, request := range requests {
+		responses[i] = h.format(request, acceptLanguage)
+	}
+	writeJSON(w, http.StatusOK, responses)
+}
+
+// format formats a single range
+func (h *Handler) format(request Request, acceptLanguage string) Response {
+	if request.From == "" || request.To == "" {
+		return Response{Error: "from and to are required"}
+	}
+
+	location := h.options.Location
+	if request.TZ != "" {
+		var err error
+		if location, err = time.LoadLocation(request.TZ); err != nil {
+			return Response{Error: fmt.Sprintf("invalid time zone %q", request.TZ)}
+		}
+	}
+
+	from, to, err := timeparse.ParseRange(request.From, request.To, location)
+	if err != nil {
+		return Response{Error: err.Error()}
+	}
+
+	locale := request.Locale
+	if locale == "" {
+		locale = negotiateLocale(acceptLanguage, h.options.DefaultLocale)
+	}
+
+	formatted := littledate.FormatDateRange(from, to, littledate.DateRangeFormatOptions{
+		Today:       h.options.Now().In(location),
+		Locale:      locale,
+		IncludeTime: request.IncludeTime,
+		Separator:   h.options.Separator,
+		Location:    location,
+	})
+	return Response{Formatted: formatted, Locale: littledate.MatchLocale(locale).Locale}
+}
+
+// negotiateLocale returns the most preferred language of an Accept-Language
+// header that has translations. The requested tag itself is returned, not the
+// translation locale, so that regional conventions such as the 24-hour clock
+// of "en-GB" are kept.
+func negotiateLocale(acceptLanguage, defaultLocale string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return defaultLocale
+	}
+	for _, tag := range tags {
+		if littledate.MatchLocale(tag.String()).Confidence != language.No {
+			return tag.String()
+		}
+	}
+	return defaultLocale
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
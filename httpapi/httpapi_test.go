@@ -0,0 +1,167 @@
+package httpapi
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+var today = time.Date(2023, 11, 15, 12, 0, 0, 0, time.UTC)
+
+func newTestServer() *httptest.Server {
+	return httptest.NewServer(NewHandler(Options{
+		Now: func() time.Time { return today },
+	}))
+}
+
+func TestHandlerGet(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+
+	tests := []struct {
+		name           string
+		query          string
+		acceptLanguage string
+		status         int
+		expected       string
+	}{
+		{
+			name:     "single range",
+			query:    "from=2023-01-01&to=2023-01-12",
+			status:   http.StatusOK,
+			expected: `{"formatted":"Jan 1 - 12","locale":"en"}`,
+		},
+		{
+			name:     "locale, time zone and time",
+			query:    "from=2023-01-01T00:00:00Z&to=2023-01-01T05:00:00Z&locale=en_GB&tz=Asia/Tokyo&includeTime=true",
+			status:   http.StatusOK,
+			expected: `{"formatted":"Jan 1, 9:00 - 14:00","locale":"en"}`,
+		},
+		{
+			name:           "locale negotiated from Accept-Language",
+			query:          "from=2023-01-01&to=2023-01-31",
+			acceptLanguage: "pt-BR, de-AT;q=0.8, en;q=0.5",
+			status:         http.StatusOK,
+			expected:       `{"formatted":"Januar 2023","locale":"de"}`,
+		},
+		{
+			name:           "explicit locale wins over Accept-Language",
+			query:          "from=2023-01-01&to=2023-01-31&locale=fr",
+			acceptLanguage: "de",
+			status:         http.StatusOK,
+			expected:       `{"formatted":"janvier 2023","locale":"fr"}`,
+		},
+		{
+			name:     "missing parameters",
+			query:    "from=2023-01-01",
+			status:   http.StatusBadRequest,
+			expected: `{"error":"from and to are required"}`,
+		},
+		{
+			name:     "invalid includeTime",
+			query:    "from=2023-01-01&to=2023-01-12&includeTime=maybe",
+			status:   http.StatusBadRequest,
+			expected: `{"error":"invalid includeTime \"maybe\""}`,
+		},
+		{
+			name:     "invalid time zone",
+			query:    "from=2023-01-01&to=2023-01-12&tz=Mars/Olympus",
+			status:   http.StatusBadRequest,
+			expected: `{"error":"invalid time zone \"Mars/Olympus\""}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, _ := http.NewRequest(http.MethodGet, server.URL+"?"+tt.query, nil)
+			if tt.acceptLanguage != "" {
+				request.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			status, body := do(t, request)
+			if status != tt.status || body != tt.expected {
+				t.Errorf("GET ?%s = %d %s, want %d %s", tt.query, status, body, tt.status, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHandlerPost(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		body     string
+		status   int
+		expected string
+	}{
+		{
+			name: "batch",
+			body: `[
+				{"from": "2023-01-01", "to": "2023-01-31", "locale": "de"},
+				{"from": "2023-01-01T00:11:00Z", "to": "2023-01-01T14:30:00Z", "includeTime": true},
+				{"from": "x", "to": "2023-01-12"}
+			]`,
+			status:   http.StatusOK,
+			expected: `[{"formatted":"Januar 2023","locale":"de"},{"formatted":"Jan 1, 12:11am - 2:30pm","locale":"en"},{"error":"cannot parse \"x\" as a date or time"}]`,
+		},
+		{
+			name:     "invalid body",
+			body:     `{"from": "2023-01-01"}`,
+			status:   http.StatusBadRequest,
+			expected: `{"error":"invalid request body: json: cannot unmarshal object into Go value of type []httpapi.Request"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(tt.body))
+			request.Header.Set("Content-Type", "application/json")
+			status, body := do(t, request)
+			if status != tt.status || body != tt.expected {
+				t.Errorf("POST = %d %s, want %d %s", status, body, tt.status, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHandlerLimits(t *testing.T) {
+	server := httptest.NewServer(NewHandler(Options{MaxBatch: 1}))
+	defer server.Close()
+
+	body := `[{"from":"2023-01-01","to":"2023-01-02"},{"from":"2023-01-01","to":"2023-01-02"}]`
+	request, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+	if status, _ := do(t, request); status != http.StatusRequestEntityTooLarge {
+		t.Errorf("POST with too many ranges = %d, want %d", status, http.StatusRequestEntityTooLarge)
+	}
+
+	small := httptest.NewServer(NewHandler(Options{MaxBodyBytes: 16}))
+	defer small.Close()
+	request, _ = http.NewRequest(http.MethodPost, small.URL, strings.NewReader(body))
+	if status, _ := do(t, request); status != http.StatusRequestEntityTooLarge {
+		t.Errorf("POST with a large body = %d, want %d", status, http.StatusRequestEntityTooLarge)
+	}
+
+	request, _ = http.NewRequest(http.MethodDelete, server.URL, nil)
+	if status, _ := do(t, request); status != http.StatusMethodNotAllowed {
+		t.Errorf("DELETE = %d, want %d", status, http.StatusMethodNotAllowed)
+	}
+}
+
+func do(t *testing.T, request *http.Request) (int, string) {
+	t.Helper()
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return response.StatusCode, strings.TrimSpace(string(body))
+}
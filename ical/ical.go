@@ -0,0 +1,360 @@
+// Package ical reads the events of iCalendar (.ics) files and labels them
+// with little-date formatted ranges.
+//
+// All-day events (VALUE=DATE) have an exclusive DTEND in iCalendar: an event
+// on January 1 ends on January 2. Such events are converted to ranges ending
+// at the last nanosecond of their last day, so they are labelled "Sun, Jan 1"
+// rather than "Jan 1, 12am - Jan 2, 12am".
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	littledate "github.com/hnq90/little-date-go"
+	"github.com/hnq90/little-date-go/internal/timeparse"
+)
+
+// Event is a VEVENT component
+type Event struct {
+	UID     string
+	Summary string
+
+	// Start and End are the first and last instant of the event. For all-day
+	// events, End is the end of the last day.
+	Start  time.Time
+	End    time.Time
+	AllDay bool
+
+	// RRule is the recurrence rule of the event, if any, without the "RRULE:" prefix
+	RRule string
+
+	// Label is the formatted range of the event, or a description of its
+	// recurrence if it repeats
+	Label string
+}
+
+// Options configures Parse
+type Options struct {
+	// Location is used for floating date-times, which have no time zone of
+	// their own. Defaults to UTC. Dates of all-day events are taken in
+	// FormatOptions.Location if it is set, so that they are not shifted.
+	Location *time.Location
+
+	// FormatOptions are used to format the labels. Set IncludeTime to show
+	// the times of events that do not last whole days.
+	FormatOptions littledate.DateRangeFormatOptions
+}
+
+// windowsZones maps the Windows time zone names used by Outlook exports to IANA names
+var windowsZones = map[string]string{
+	"UTC":                            "UTC",
+	"GMT Standard Time":              "Europe/London",
+	"W. Europe Standard Time":        "Europe/Berlin",
+	"Romance Standard Time":          "Europe/Paris",
+	"Central Europe Standard Time":   "Europe/Budapest",
+	"E. Europe Standard Time":        "Europe/Chisinau",
+	"Eastern Standard Time":          "America/New_York",
+	"Central Standard Time":          "America/Chicago",
+	"Mountain Standard Time":         "America/Denver",
+	"Pacific Standard Time":          "America/Los_Angeles",
+	"Tokyo Standard Time":            "Asia/Tokyo",
+	"Korea Standard Time":            "Asia/Seoul",
+	"China Standard Time":            "Asia/Shanghai",
+	"Taipei Standard Time":           "Asia/Taipei",
+	"SE Asia Standard Time":          "Asia/Bangkok",
+	"India Standard Time":            "Asia/Kolkata",
+	"AUS Eastern Standard Time":      "Australia/Sydney",
+	"New Zealand Standard Time":      "Pacific/Auckland",
+	"E. South America Standard Time": "America/Sao_Paulo",
+}
+
+// property is a content line, e.g. "DTSTART;TZID=Europe/Paris:20230101T090000"
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse reads the VEVENT components of an iCalendar stream, in the order
+// they appear. Other components, such as VTODO and VALARM, are skipped.
+func Parse(r io.Reader, options Options) ([]Event, error) {
+	if options.Location == nil {
+		options.Location = time.UTC
+	}
+
+	var events []Event
+	var current []property
+	depth, inEvent := 0, false
+
+	err := readLines(r, func(line int, text string) error {
+		prop, err := parseProperty(text)
+		if err != nil {
+			return fmt.Errorf("ical: line %d: %w", line, err)
+		}
+
+		switch prop.name {
+		case "BEGIN":
+			if inEvent {
+				depth++
+			} else if strings.EqualFold(prop.value, "VEVENT") {
+				inEvent, current = true, nil
+			}
+		case "END":
+			if !inEvent {
+				break
+			}
+			if depth > 0 {
+				depth--
+				break
+			}
+			event, err := newEvent(current, options)
+			if err != nil {
+				return fmt.Errorf("ical: event ending on line %d: %w", line, err)
+			}
+			events = append(events, event)
+			inEvent = false
+		default:
+			if inEvent && depth == 0 {
+				current = append(current, prop)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if inEvent {
+		return nil, errors.New("ical: unterminated VEVENT")
+	}
+	return events, nil
+}
+
+// readLines calls fn for every unfolded content line with the number of its first physical line
+func readLines(r io.Reader, fn func(line int, text string) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var text strings.Builder
+	start, number := 0, 0
+	flush := func() error {
+		if text.Len() == 0 {
+			return nil
+		}
+		defer text.Reset()
+		return fn(start, text.String())
+	}
+
+	for scanner.Scan() {
+		number++
+		physical := strings.TrimSuffix(scanner.Text(), "\r")
+		if len(physical) > 0 && (physical[0] == ' ' || physical[0] == '\t') {
+			// A folded continuation of the previous line
+			text.WriteString(physical[1:])
+			continue
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		start = number
+		text.WriteString(physical)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("ical: %w", err)
+	}
+	return flush()
+}
+
+// parseProperty splits a content line into its name, parameters and value
+func parseProperty(text string) (property, error) {
+	prop := property{params: make(map[string]string)}
+
+	// The value starts at the first colon outside a quoted parameter value
+	quoted, colon := false, -1
+	for i := 0; i < len(text) && colon < 0; i++ {
+		switch text[i] {
+		case '"':
+			quoted = !quoted
+		case ':':
+			if !quoted {
+				colon = i
+			}
+		}
+	}
+	if colon < 0 {
+		return property{}, fmt.Errorf("invalid content line %q", text)
+	}
+	prop.value = text[colon+1:]
+
+	parts := strings.Split(text[:colon], ";")
+	prop.name = strings.ToUpper(parts[0])
+	for _, param := range parts[1:] {
+		name, value, ok := strings.Cut(param, "=")
+		if !ok {
+			return property{}, fmt.Errorf("invalid parameter %q", param)
+		}
+		prop.params[strings.ToUpper(name)] = strings.Trim(value, `"`)
+	}
+	return prop, nil
+}
+
+// newEvent builds an event from the properties of a VEVENT
+func newEvent(props []property, options Options) (Event, error) {
+	var event Event
+	var dtstart, dtend, duration *property
+	for i := range props {
+		prop := &props[i]
+		switch prop.name {
+		case "UID":
+			event.UID = prop.value
+		case "SUMMARY":
+			event.Summary = unescapeText(prop.value)
+		case "RRULE":
+			event.RRule = prop.value
+		case "DTSTART":
+			dtstart = prop
+		case "DTEND":
+			dtend = prop
+		case "DURATION":
+			duration = prop
+		}
+	}
+
+	if dtstart == nil {
+		return Event{}, errors.New("missing DTSTART")
+	}
+	dates := options.Location
+	if options.FormatOptions.Location != nil {
+		dates = options.FormatOptions.Location
+	}
+
+	start, allDay, err := parseDateTime(*dtstart, options.Location, dates)
+	if err != nil {
+		return Event{}, fmt.Errorf("invalid DTSTART: %w", err)
+	}
+	event.Start, event.AllDay = start, allDay
+
+	// The end is exclusive; an event without an end lasts one day if it is
+	// an all-day event and no time at all otherwise
+	var end time.Time
+	switch {
+	case dtend != nil:
+		end, _, err = parseDateTime(*dtend, options.Location, dates)
+		if err != nil {
+			return Event{}, fmt.Errorf("invalid DTEND: %w", err)
+		}
+	case duration != nil:
+		// Days and weeks are calendar days, which are 23 or 25 hours long
+		// on the days the clocks change
+		value := strings.TrimSpace(duration.value)
+		negative := strings.HasPrefix(value, "-")
+		if negative || strings.HasPrefix(value, "+") {
+			value = value[1:]
+		}
+		period, err := timeparse.ParsePeriod(value)
+		if err == nil && (period.Years != 0 || period.Months != 0) {
+			err = fmt.Errorf("cannot use years or months in duration %q", duration.value)
+		}
+		if err != nil {
+			return Event{}, fmt.Errorf("invalid DURATION: %w", err)
+		}
+		if negative {
+			end = period.Before(start)
+		} else {
+			end = period.After(start)
+		}
+	case allDay:
+		end = start.AddDate(0, 0, 1)
+	default:
+		end = start
+	}
+	if end.Before(start) {
+		return Event{}, errors.New("DTEND is before DTSTART")
+	}
+
+	event.End = end
+	if allDay {
+		// The last day of an all-day event is the one before DTEND
+		event.End = end.Add(-time.Nanosecond)
+		if event.End.Before(start) {
+			event.End = time.Date(start.Year(), start.Month(), start.Day(), 23, 59, 59, 999999999, start.Location())
+		}
+	}
+
+	event.Label = littledate.FormatDateRange(event.Start, event.End, options.FormatOptions)
+	if event.RRule != "" {
+		if label, err := littledate.FormatRecurringEvent(event.RRule, event.Start, event.End, options.FormatOptions); err == nil {
+			event.Label = label
+		}
+	}
+	return event, nil
+}
+
+// parseDateTime parses a DATE or DATE-TIME value and reports whether it was
+// a date. Floating date-times are taken in floating and dates in dates.
+func parseDateTime(prop property, floating, dates *time.Location) (time.Time, bool, error) {
+	location := floating
+	if tzid := prop.params["TZID"]; tzid != "" {
+		var err error
+		location, err = loadLocation(tzid)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+	}
+
+	if strings.EqualFold(prop.params["VALUE"], "DATE") || len(prop.value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", prop.value, dates)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("cannot parse %q as a date", prop.value)
+		}
+		return t, true, nil
+	}
+	if t, err := time.Parse("20060102T150405Z", prop.value); err == nil {
+		return t, false, nil
+	}
+	t, err := time.ParseInLocation("20060102T150405", prop.value, location)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("cannot parse %q as a date-time", prop.value)
+	}
+	return t, false, nil
+}
+
+// loadLocation resolves an IANA or Windows time zone name
+func loadLocation(tzid string) (*time.Location, error) {
+	// A leading solidus marks a globally unique name
+	tzid = strings.TrimPrefix(tzid, "/")
+	if name, ok := windowsZones[tzid]; ok {
+		tzid = name
+	}
+	location, err := time.LoadLocation(tzid)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q", tzid)
+	}
+	return location, nil
+}
+
+// unescapeText reverses the escaping of TEXT values
+func unescapeText(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			i++
+			switch value[i] {
+			case 'n', 'N':
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(value[i])
+			}
+			continue
+		}
+		b.WriteByte(value[i])
+	}
+	return b.String()
+}
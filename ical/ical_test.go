@@ -0,0 +1,151 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	littledate "github.com/hnq90/little-date-go"
+)
+
+var today = time.Date(2023, 11, 15, 12, 0, 0, 0, time.UTC)
+
+const calendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Google Inc//Google Calendar 70.9054//EN\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:all-day@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20230101\r\n" +
+	"DTEND;VALUE=DATE:20230102\r\n" +
+	"SUMMARY:New Year\\, observed\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:trip@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20230110\r\n" +
+	"DTEND;VALUE=DATE:20230113\r\n" +
+	"SUMMARY:Trip to\r\n" +
+	"  Lisbon\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER:-PT15M\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:meeting@example.com\r\n" +
+	"DTSTART;TZID=Europe/Berlin:20230105T090000\r\n" +
+	"DTEND;TZID=Europe/Berlin:20230105T103000\r\n" +
+	"SUMMARY:Meeting\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:outlook@example.com\r\n" +
+	"DTSTART;TZID=\"Pacific Standard Time\":20230106T090000\r\n" +
+	"DURATION:PT1H\r\n" +
+	"SUMMARY:Outlook call\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:standup@example.com\r\n" +
+	"DTSTART:20230102T083000Z\r\n" +
+	"DTEND:20230102T084500Z\r\n" +
+	"RRULE:FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20230331T235959Z\r\n" +
+	"SUMMARY:Standup\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VTODO\r\n" +
+	"UID:todo@example.com\r\n" +
+	"DTSTART:20230101T000000Z\r\n" +
+	"END:VTODO\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParse(t *testing.T) {
+	events, err := Parse(strings.NewReader(calendar), Options{
+		FormatOptions: littledate.DateRangeFormatOptions{Today: today, IncludeTime: true, Location: time.UTC},
+	})
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expected := []struct {
+		uid     string
+		summary string
+		allDay  bool
+		label   string
+	}{
+		{uid: "all-day@example.com", summary: "New Year, observed", allDay: true, label: "Sun, Jan 1"},
+		{uid: "trip@example.com", summary: "Trip to Lisbon", allDay: true, label: "Jan 10 - 12"},
+		{uid: "meeting@example.com", summary: "Meeting", label: "Jan 5, 8am - 9:30am"},
+		{uid: "outlook@example.com", summary: "Outlook call", label: "Jan 6, 5pm - 6pm"},
+		{uid: "standup@example.com", summary: "Standup", label: "Every Monday and Wednesday, 8:30am - 8:45am, Jan 2 - Mar 31"},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("Parse() = %d events, want %d", len(events), len(expected))
+	}
+	for i, want := range expected {
+		event := events[i]
+		if event.UID != want.uid || event.Summary != want.summary || event.AllDay != want.allDay || event.Label != want.label {
+			t.Errorf("Parse()[%d] = %q, %q, %v, %q, want %q, %q, %v, %q",
+				i, event.UID, event.Summary, event.AllDay, event.Label, want.uid, want.summary, want.allDay, want.label)
+		}
+	}
+}
+
+func TestParseAllDayInLocation(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	events, err := Parse(strings.NewReader(calendar), Options{
+		FormatOptions: littledate.DateRangeFormatOptions{Today: today, Location: tokyo},
+	})
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	// All-day events keep their dates when shown in another time zone
+	if events[0].Label != "Sun, Jan 1" {
+		t.Errorf("Parse() label = %v, want Sun, Jan 1", events[0].Label)
+	}
+	if end := events[0].End; !end.Equal(time.Date(2023, 1, 1, 23, 59, 59, 999999999, tokyo)) {
+		t.Errorf("Parse() end = %v, want the end of Jan 1 in Tokyo", end)
+	}
+}
+
+func TestParseDurationAcrossDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+	ics := "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20230326\nDURATION:P1D\nEND:VEVENT\n"
+	events, err := Parse(strings.NewReader(ics), Options{
+		Location:      berlin,
+		FormatOptions: littledate.DateRangeFormatOptions{Today: today},
+	})
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	// The clocks go forward on Mar 26, which is one day of 23 hours
+	if events[0].Label != "Sun, Mar 26" {
+		t.Errorf("Parse() label = %v, want Sun, Mar 26", events[0].Label)
+	}
+	if end := events[0].End; !end.Equal(time.Date(2023, 3, 26, 23, 59, 59, 999999999, berlin)) {
+		t.Errorf("Parse() end = %v, want the end of Mar 26 in Berlin", end)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		ics  string
+	}{
+		{name: "missing DTSTART", ics: "BEGIN:VEVENT\nSUMMARY:x\nEND:VEVENT\n"},
+		{name: "invalid date", ics: "BEGIN:VEVENT\nDTSTART:2023-01-01\nEND:VEVENT\n"},
+		{name: "unknown time zone", ics: "BEGIN:VEVENT\nDTSTART;TZID=Mars/Olympus:20230101T090000\nEND:VEVENT\n"},
+		{name: "end before start", ics: "BEGIN:VEVENT\nDTSTART:20230102T090000Z\nDTEND:20230101T090000Z\nEND:VEVENT\n"},
+		{name: "unterminated", ics: "BEGIN:VEVENT\nDTSTART:20230101T090000Z\n"},
+		{name: "invalid line", ics: "BEGIN:VEVENT\nnot a property\nEND:VEVENT\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(strings.NewReader(tt.ics), Options{}); err == nil {
+				t.Errorf("Parse() error = nil, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Command genlocales generates the built-in and embedded translations of
+// little-date-go from the JSON files in i18n/locales, which are the single
+// source of truth for month and weekday names.
+//
+// Run it from the repository root with:
+//
+//	go generate ./...
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+// defaultLocale is listed first in the generated locales, since the
+// formatter falls back to the first locale when nothing else matches
+const defaultLocale = "en"
+
+// Files written by the generator, relative to the repository root
+const (
+	builtinFile  = "locales_gen.go"
+	embeddedFile = "resources_embed.go"
+)
+
+// localeFile is a parsed translation file
+type localeFile struct {
+	locale   string
+	data     []byte
+	messages []*i18n.Message
+}
+
+func main() {
+	root := flag.String("root", ".", "repository root")
+	flag.Parse()
+
+	files, err := loadLocaleFiles(filepath.Join(*root, "i18n", "locales"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if problems := checkLocaleFiles(files); len(problems) > 0 {
+		log.Fatalf("inconsistent translations:\n%s", strings.Join(problems, "\n"))
+	}
+
+	outputs := map[string]func([]*localeFile) ([]byte, error){
+		builtinFile:  generateBuiltin,
+		embeddedFile: generateEmbedded,
+	}
+	for name, generate := range outputs {
+		src, err := generate(files)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(*root, name), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// loadLocaleFiles parses all translation files in dir, with the default locale first
+func loadLocaleFiles(dir string) ([]*localeFile, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*localeFile
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		parsed, err := i18n.ParseMessageFileBytes(data, path, map[string]i18n.UnmarshalFunc{
+			"json": json.Unmarshal,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		messages := parsed.Messages
+		sort.Slice(messages, func(i, j int) bool {
+			return lessMessageID(messages[i].ID, messages[j].ID)
+		})
+		files = append(files, &localeFile{
+			locale:   strings.TrimSuffix(filepath.Base(path), ".json"),
+			data:     data,
+			messages: messages,
+		})
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		if files[i].locale == defaultLocale || files[j].locale == defaultLocale {
+			return files[i].locale == defaultLocale
+		}
+		return files[i].locale < files[j].locale
+	})
+	if len(files) == 0 || files[0].locale != defaultLocale {
+		return nil, fmt.Errorf("%s: missing %s.json", dir, defaultLocale)
+	}
+	return files, nil
+}
+
+// checkLocaleFiles reports every locale whose message IDs differ from the default locale
+func checkLocaleFiles(files []*localeFile) []string {
+	reference := messageIDs(files[0])
+
+	var problems []string
+	for _, file := range files[1:] {
+		ids := messageIDs(file)
+		for id := range reference {
+			if !ids[id] {
+				problems = append(problems, fmt.Sprintf("%s.json: missing %q", file.locale, id))
+			}
+		}
+		for id := range ids {
+			if !reference[id] {
+				problems = append(problems, fmt.Sprintf("%s.json: extra %q", file.locale, id))
+			}
+		}
+	}
+
+	sort.Strings(problems)
+	return problems
+}
+
+func messageIDs(file *localeFile) map[string]bool {
+	ids := make(map[string]bool, len(file.messages))
+	for _, message := range file.messages {
+		ids[message.ID] = true
+	}
+	return ids
+}
+
+// lessMessageID orders message IDs with numeric parts compared as numbers,
+// so that "month.long.2" comes before "month.long.10"
+func lessMessageID(a, b string) bool {
+	partsA := strings.Split(a, ".")
+	partsB := strings.Split(b, ".")
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		if partsA[i] == partsB[i] {
+			continue
+		}
+		numA, errA := strconv.Atoi(partsA[i])
+		numB, errB := strconv.Atoi(partsB[i])
+		if errA == nil && errB == nil {
+			return numA < numB
+		}
+		return partsA[i] < partsB[i]
+	}
+	return len(partsA) < len(partsB)
+}
+
+// generateBuiltin generates the Go source of the built-in translations
+func generateBuiltin(files []*localeFile) ([]byte, error) {
+	var buf bytes.Buffer
+	writeHeader(&buf, "")
+	buf.WriteString("import \"github.com/nicksnyder/go-i18n/v2/i18n\"\n\n")
+
+	buf.WriteString("// Supported languages with their built-in translations, one per file in i18n/locales.\n")
+	buf.WriteString("// The first entry is the default used when no other locale matches.\n")
+	buf.WriteString("var supportedLocales = []string{")
+	for i, file := range files {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(strconv.Quote(file.locale))
+	}
+	buf.WriteString("}\n\n")
+
+	buf.WriteString("// Built-in translations, used when no external translation files are found\n")
+	buf.WriteString("var builtinTranslations = map[string][]*i18n.Message{\n")
+	for _, file := range files {
+		fmt.Fprintf(&buf, "%q: {\n", file.locale)
+		for _, message := range file.messages {
+			fmt.Fprintf(&buf, "{ID: %q", message.ID)
+			fields := []struct{ name, value string }{
+				{"Description", message.Description},
+				{"Zero", message.Zero},
+				{"One", message.One},
+				{"Two", message.Two},
+				{"Few", message.Few},
+				{"Many", message.Many},
+				{"Other", message.Other},
+			}
+			for _, field := range fields {
+				if field.value != "" {
+					fmt.Fprintf(&buf, ", %s: %q", field.name, field.value)
+				}
+			}
+			buf.WriteString("},\n")
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// generateEmbedded generates the Go source of the translations embedded with -tags=embed_resources
+func generateEmbedded(files []*localeFile) ([]byte, error) {
+	var buf bytes.Buffer
+	writeHeader(&buf, "embed_resources")
+
+	buf.WriteString("// Translations embedded into the binary, keyed by locale.\n")
+	buf.WriteString("// They are loaded by newDefaultRegistry after the built-in and on-disk translations.\n")
+	buf.WriteString("var embeddedTranslations = map[string]string{\n")
+	for _, file := range files {
+		if bytes.ContainsRune(file.data, '`') {
+			return nil, fmt.Errorf("%s.json: backquotes cannot be embedded", file.locale)
+		}
+		fmt.Fprintf(&buf, "%q: `%s`,\n", file.locale, bytes.TrimSpace(file.data))
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+func writeHeader(buf *bytes.Buffer, buildTag string) {
+	buf.WriteString("// Code generated by go run ./internal/genlocales; DO NOT EDIT.\n\n")
+	if buildTag != "" {
+		fmt.Fprintf(buf, "//go:build %s\n// +build %s\n\n", buildTag, buildTag)
+	}
+	buf.WriteString("package littledate\n\n")
+}
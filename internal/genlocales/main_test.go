@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+const root = "../.."
+
+// TestLocaleFiles fails when a translation file is missing keys or has extra keys
+func TestLocaleFiles(t *testing.T) {
+	files, err := loadLocaleFiles(filepath.Join(root, "i18n", "locales"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, problem := range checkLocaleFiles(files) {
+		t.Error(problem)
+	}
+}
+
+// TestGeneratedFiles fails when the generated files are out of date.
+// Run "go generate ./..." from the repository root to update them.
+func TestGeneratedFiles(t *testing.T) {
+	files, err := loadLocaleFiles(filepath.Join(root, "i18n", "locales"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outputs := map[string]func([]*localeFile) ([]byte, error){
+		builtinFile:  generateBuiltin,
+		embeddedFile: generateEmbedded,
+	}
+	for name, generate := range outputs {
+		expected, err := generate(files)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual, err := os.ReadFile(filepath.Join(root, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(actual, expected) {
+			t.Errorf("%s is out of date, run go generate ./...", name)
+		}
+	}
+}
+
+func TestCheckLocaleFiles(t *testing.T) {
+	files := []*localeFile{
+		{locale: "en", messages: []*i18n.Message{{ID: "month.long.1"}, {ID: "month.long.2"}}},
+		{locale: "fr", messages: []*i18n.Message{{ID: "month.long.1"}, {ID: "month.long.3"}}},
+	}
+
+	expected := []string{
+		`fr.json: extra "month.long.3"`,
+		`fr.json: missing "month.long.2"`,
+	}
+	if problems := checkLocaleFiles(files); !reflect.DeepEqual(problems, expected) {
+		t.Errorf("checkLocaleFiles() = %v, want %v", problems, expected)
+	}
+}
+
+func TestLessMessageID(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"month.long.2", "month.long.10", true},
+		{"month.long.10", "month.long.2", false},
+		{"month.long.12", "month.short.1", true},
+		{"month", "month.long", true},
+	}
+
+	for _, tt := range tests {
+		if result := lessMessageID(tt.a, tt.b); result != tt.expected {
+			t.Errorf("lessMessageID(%q, %q) = %v, want %v", tt.a, tt.b, result, tt.expected)
+		}
+	}
+}
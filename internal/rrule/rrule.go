@@ -0,0 +1,179 @@
+// Package rrule parses the subset of RFC 5545 recurrence rules that
+// little-date-go can describe: FREQ, INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL.
+package rrule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ of a rule
+type Frequency int
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencies = map[string]Frequency{
+	"DAILY":   Daily,
+	"WEEKLY":  Weekly,
+	"MONTHLY": Monthly,
+	"YEARLY":  Yearly,
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// WeekdayNum is an entry of BYDAY, e.g. "2TU" for the second Tuesday or
+// "-1FR" for the last Friday. N is zero for every such weekday.
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// Rule is a parsed recurrence rule
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+
+	// Count is the number of occurrences, or zero if the rule is not limited by a count
+	Count int
+
+	// Until is the last possible occurrence, or the zero time if the rule is not limited by a date
+	Until time.Time
+}
+
+// Parse parses a recurrence rule such as "FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20230331".
+// An optional "RRULE:" prefix is ignored. UNTIL values without a UTC
+// designator are interpreted in location.
+func Parse(value string, location *time.Location) (Rule, error) {
+	rule := Rule{Interval: 1}
+
+	value = strings.TrimSpace(value)
+	if len(value) >= 6 && strings.EqualFold(value[:6], "RRULE:") {
+		value = value[6:]
+	}
+
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+		name, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return Rule{}, fmt.Errorf("invalid rule part %q", part)
+		}
+		name = strings.ToUpper(name)
+		if seen[name] {
+			return Rule{}, fmt.Errorf("duplicate rule part %s", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			freq, ok := frequencies[strings.ToUpper(val)]
+			if !ok {
+				return Rule{}, fmt.Errorf("unsupported frequency %q", val)
+			}
+			rule.Freq = freq
+		case "INTERVAL":
+			rule.Interval, err = parsePositive(name, val)
+		case "COUNT":
+			rule.Count, err = parsePositive(name, val)
+		case "UNTIL":
+			rule.Until, err = parseUntil(val, location)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(val)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseByMonthDay(val)
+		case "WKST":
+			// The week start does not change how the rule is described
+		default:
+			return Rule{}, fmt.Errorf("unsupported rule part %s", name)
+		}
+		if err != nil {
+			return Rule{}, err
+		}
+	}
+
+	if !seen["FREQ"] {
+		return Rule{}, fmt.Errorf("missing FREQ in %q", value)
+	}
+	if seen["COUNT"] && seen["UNTIL"] {
+		return Rule{}, fmt.Errorf("COUNT and UNTIL must not both be set")
+	}
+	return rule, nil
+}
+
+func parsePositive(name, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid %s %q", name, value)
+	}
+	return n, nil
+}
+
+// parseUntil parses a DATE or DATE-TIME value
+func parseUntil(value string, location *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t.In(location), nil
+	}
+	for _, layout := range []string{"20060102T150405", "20060102"} {
+		if t, err := time.ParseInLocation(layout, value, location); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL %q", value)
+}
+
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+	for _, item := range strings.Split(value, ",") {
+		item = strings.ToUpper(strings.TrimSpace(item))
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid BYDAY %q", item)
+		}
+		weekday, ok := weekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid BYDAY %q", item)
+		}
+
+		day := WeekdayNum{Weekday: weekday}
+		if prefix := item[:len(item)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("invalid BYDAY %q", item)
+			}
+			day.N = n
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+func parseByMonthDay(value string) ([]int, error) {
+	var days []int
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(item))
+		if err != nil || n == 0 || n < -31 || n > 31 {
+			return nil, fmt.Errorf("invalid BYMONTHDAY %q", item)
+		}
+		days = append(days, n)
+	}
+	return days, nil
+}
@@ -0,0 +1,76 @@
+package rrule
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected Rule
+		wantErr  bool
+	}{
+		{
+			name:     "daily",
+			value:    "FREQ=DAILY",
+			expected: Rule{Freq: Daily, Interval: 1},
+		},
+		{
+			name:     "prefix and interval",
+			value:    "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;WKST=MO",
+			expected: Rule{Freq: Weekly, Interval: 2, ByDay: []WeekdayNum{{Weekday: time.Monday}, {Weekday: time.Wednesday}}},
+		},
+		{
+			name:     "nth weekday",
+			value:    "freq=monthly;byday=2TU,-1fr",
+			expected: Rule{Freq: Monthly, Interval: 1, ByDay: []WeekdayNum{{N: 2, Weekday: time.Tuesday}, {N: -1, Weekday: time.Friday}}},
+		},
+		{
+			name:     "month days and count",
+			value:    "FREQ=MONTHLY;BYMONTHDAY=1,15,-1;COUNT=10",
+			expected: Rule{Freq: Monthly, Interval: 1, ByMonthDay: []int{1, 15, -1}, Count: 10},
+		},
+		{
+			name:     "until UTC",
+			value:    "FREQ=YEARLY;UNTIL=20230331T150000Z",
+			expected: Rule{Freq: Yearly, Interval: 1, Until: time.Date(2023, 4, 1, 0, 0, 0, 0, tokyo)},
+		},
+		{
+			name:     "until date",
+			value:    "FREQ=DAILY;UNTIL=20230331",
+			expected: Rule{Freq: Daily, Interval: 1, Until: time.Date(2023, 3, 31, 0, 0, 0, 0, tokyo)},
+		},
+		{name: "missing FREQ", value: "INTERVAL=2", wantErr: true},
+		{name: "unsupported frequency", value: "FREQ=HOURLY", wantErr: true},
+		{name: "unsupported part", value: "FREQ=YEARLY;BYMONTH=1", wantErr: true},
+		{name: "invalid interval", value: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{name: "invalid weekday", value: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+		{name: "invalid month day", value: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{name: "count and until", value: "FREQ=DAILY;COUNT=2;UNTIL=20230101", wantErr: true},
+		{name: "duplicate part", value: "FREQ=DAILY;FREQ=WEEKLY", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.value, tokyo)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !rule.Until.Equal(tt.expected.Until) {
+				t.Errorf("Parse() Until = %v, want %v", rule.Until, tt.expected.Until)
+			}
+			rule.Until, tt.expected.Until = time.Time{}, time.Time{}
+			if !reflect.DeepEqual(rule, tt.expected) {
+				t.Errorf("Parse() = %+v, want %+v", rule, tt.expected)
+			}
+		})
+	}
+}
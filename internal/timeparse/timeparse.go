@@ -0,0 +1,337 @@
+// Package timeparse parses the date and time values accepted by the
+// little-date-go tools: RFC 3339 timestamps, local date-times, plain dates
+// and ISO 8601 intervals and durations.
+package timeparse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Layouts accepted for times with a UTC offset, which are converted to the given location
+var offsetLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04Z07:00",
+}
+
+// Layouts accepted for times without a UTC offset, which are interpreted in the given location
+var localLayouts = []string{
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// precision is the smallest unit given in a value
+type precision int
+
+const (
+	precisionYear precision = iota
+	precisionMonth
+	precisionDay
+	precisionTime
+)
+
+// Parse parses an RFC 3339 timestamp, a local date-time or a plain date.
+// Timestamps with an offset are converted to location, other values are
+// interpreted in it. It reports whether the value was a plain date.
+func Parse(value string, location *time.Location) (time.Time, bool, error) {
+	t, p, err := parseValue(value, location)
+	if err != nil || p < precisionDay {
+		return time.Time{}, false, fmt.Errorf("cannot parse %q as a date or time", strings.TrimSpace(value))
+	}
+	return t, p == precisionDay, nil
+}
+
+// parseValue parses the values accepted by Parse, as well as the reduced
+// precision dates "2006-01" and "2006" used in intervals
+func parseValue(value string, location *time.Location) (time.Time, precision, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range offsetLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.In(location), precisionTime, nil
+		}
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, location); err == nil {
+		return t, precisionDay, nil
+	}
+	for _, layout := range localLayouts {
+		if t, err := time.ParseInLocation(layout, value, location); err == nil {
+			return t, precisionTime, nil
+		}
+	}
+	if t, err := time.ParseInLocation("2006-01", value, location); err == nil {
+		return t, precisionMonth, nil
+	}
+	if len(value) == 4 {
+		if t, err := time.ParseInLocation("2006", value, location); err == nil {
+			return t, precisionYear, nil
+		}
+	}
+	return time.Time{}, 0, fmt.Errorf("cannot parse %q as a date or time", value)
+}
+
+// endOf returns the last instant of the year, month or day starting at t
+func endOf(t time.Time, p precision) time.Time {
+	switch p {
+	case precisionYear:
+		return t.AddDate(1, 0, 0).Add(-time.Nanosecond)
+	case precisionMonth:
+		return t.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	case precisionDay:
+		return t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	default:
+		return t
+	}
+}
+
+// ParseRange parses the start and end of a range. A plain end date includes the whole day.
+// It is an error for the range to end before it starts.
+func ParseRange(start, end string, location *time.Location) (time.Time, time.Time, error) {
+	from, _, err := Parse(start, location)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to, dateOnly, err := Parse(end, location)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if dateOnly {
+		to = endOf(to, precisionDay)
+	}
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%q ends before %q", strings.TrimSpace(end), strings.TrimSpace(start))
+	}
+	return from, to, nil
+}
+
+// ParseInterval parses an ISO 8601 interval in one of the forms START/END,
+// START/DURATION and DURATION/END:
+//
+//	2023-01-01/2023-01-12
+//	2023-01-01T09:00/PT2H
+//	P1W/2023-03-26
+//
+// Leading fields that END shares with START may be left out, as in
+// "2023-01-01/12" or "2023-01-01T09:00/11:00", and dates may be reduced to a
+// month ("2023-01") or a year ("2023"). A date includes its whole day, month
+// or year: "P1W/2023-03-26" is the week from March 20 to the end of March 26.
+func ParseInterval(interval string, location *time.Location) (time.Time, time.Time, error) {
+	start, end, ok := strings.Cut(strings.TrimSpace(interval), "/")
+	if !ok {
+		return time.Time{}, time.Time{}, fmt.Errorf("%q is not an interval, expected START/END", interval)
+	}
+	startPeriod, endPeriod := isPeriod(start), isPeriod(end)
+
+	var from, to time.Time
+	switch {
+	case startPeriod && endPeriod:
+		return time.Time{}, time.Time{}, fmt.Errorf("%q has no start or end", interval)
+	case startPeriod:
+		period, err := ParsePeriod(start)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		t, p, err := parseValue(end, location)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		to = endOf(t, p)
+		if p == precisionTime {
+			from = period.Before(to)
+		} else {
+			from = period.Before(to.Add(time.Nanosecond))
+		}
+	case endPeriod:
+		period, err := ParsePeriod(end)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		var p precision
+		from, p, err = parseValue(start, location)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		to = period.After(from)
+		if p != precisionTime {
+			to = to.Add(-time.Nanosecond)
+		}
+	default:
+		var err error
+		from, _, err = parseValue(start, location)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		t, p, err := parseValue(completeEnd(start, end), location)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		to = endOf(t, p)
+	}
+
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%q ends before it starts", interval)
+	}
+	return from, to, nil
+}
+
+// isPeriod reports whether an interval part is a duration
+func isPeriod(value string) bool {
+	value = strings.TrimSpace(value)
+	return value != "" && (value[0] == 'P' || value[0] == 'p')
+}
+
+// completeEnd fills in the leading fields that an abbreviated end leaves out
+// from the start, e.g. "12" after "2023-01-01" becomes "2023-01-12". An end
+// that is not made of the last fields of the start, such as "2023-02", is
+// left as it is.
+func completeEnd(start, end string) string {
+	start, end = strings.TrimSpace(start), strings.TrimSpace(end)
+	startDate, startTime, _ := strings.Cut(start, "T")
+	endDate, endTime, endHasTime := strings.Cut(end, "T")
+	if !endHasTime && strings.Contains(end, ":") {
+		endDate, endTime, endHasTime = "", end, true
+	}
+
+	if endDate == "" || isFieldSuffix(startDate, endDate) {
+		endDate = startDate[:len(startDate)-len(endDate)] + endDate
+	}
+	if !endHasTime {
+		return endDate
+	}
+
+	// A time without a UTC offset takes the offset of the start
+	if zone := timeZone(startTime); zone != "" && timeZone(endTime) == "" {
+		endTime += zone
+	}
+	return endDate + "T" + endTime
+}
+
+// isFieldSuffix reports whether end has the shape of the last fields of
+// start, e.g. "02-12" of "2023-01-01" but not "2023-02"
+func isFieldSuffix(start, end string) bool {
+	n := len(start) - len(end)
+	if n <= 0 || start[n-1] != '-' {
+		return false
+	}
+	for i := 0; i < len(end); i++ {
+		if (start[n+i] == '-') != (end[i] == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// timeZone returns the UTC offset at the end of a time, e.g. "Z" or "+09:00"
+func timeZone(value string) string {
+	if strings.HasSuffix(value, "Z") || strings.HasSuffix(value, "z") {
+		return value[len(value)-1:]
+	}
+	if i := strings.LastIndexAny(value, "+-"); i >= 0 {
+		return value[i:]
+	}
+	return ""
+}
+
+// Period is an ISO 8601 duration. Years, months and days are calendar units,
+// whose length depends on the date they are added to.
+type Period struct {
+	Years    int
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+// After returns the time the period after t
+func (p Period) After(t time.Time) time.Time {
+	return t.AddDate(p.Years, p.Months, p.Days).Add(p.Duration)
+}
+
+// Before returns the time the period before t
+func (p Period) Before(t time.Time) time.Time {
+	return t.AddDate(-p.Years, -p.Months, -p.Days).Add(-p.Duration)
+}
+
+// ParsePeriod parses an ISO 8601 duration such as "P1Y2M", "P1W" or "P1DT12H".
+// Weeks are converted to days.
+func ParsePeriod(value string) (Period, error) {
+	s := strings.ToUpper(strings.TrimSpace(value))
+	if !strings.HasPrefix(s, "P") || len(s) == 1 {
+		return Period{}, fmt.Errorf("cannot parse %q as a duration", value)
+	}
+	s = s[1:]
+
+	var period Period
+	inTime := false
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return Period{}, fmt.Errorf("cannot parse %q as a duration", value)
+			}
+			inTime = true
+			s = s[1:]
+			continue
+		}
+
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == 0 || i == len(s) {
+			return Period{}, fmt.Errorf("cannot parse %q as a duration", value)
+		}
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return Period{}, fmt.Errorf("cannot parse %q as a duration", value)
+		}
+
+		switch {
+		case !inTime && s[i] == 'Y':
+			period.Years += n
+		case !inTime && s[i] == 'M':
+			period.Months += n
+		case !inTime && s[i] == 'W':
+			period.Days += 7 * n
+		case !inTime && s[i] == 'D':
+			period.Days += n
+		case inTime && s[i] == 'H':
+			period.Duration += time.Duration(n) * time.Hour
+		case inTime && s[i] == 'M':
+			period.Duration += time.Duration(n) * time.Minute
+		case inTime && s[i] == 'S':
+			period.Duration += time.Duration(n) * time.Second
+		default:
+			return Period{}, fmt.Errorf("cannot parse %q as a duration", value)
+		}
+		s = s[i+1:]
+	}
+	return period, nil
+}
+
+// ParseDuration parses an ISO 8601 duration such as "P1W", "P1DT12H" or
+// "PT1H30M". Years and months are not supported, as their length depends on
+// the date they are added to, and days are taken as 24 hours. A leading sign
+// is allowed.
+func ParseDuration(value string) (time.Duration, error) {
+	s := strings.TrimSpace(value)
+	negative := strings.HasPrefix(s, "-")
+	if negative || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+
+	period, err := ParsePeriod(s)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse %q as a duration", value)
+	}
+	if period.Years != 0 || period.Months != 0 {
+		return 0, fmt.Errorf("cannot use years or months in duration %q", value)
+	}
+
+	d := time.Duration(period.Days)*24*time.Hour + period.Duration
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
@@ -0,0 +1,199 @@
+package timeparse
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRange(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		start string
+		end   string
+		from  time.Time
+		to    time.Time
+	}{
+		{
+			name:  "plain dates include the whole end day",
+			start: "2023-01-01",
+			end:   "2023-01-12",
+			from:  time.Date(2023, 1, 1, 0, 0, 0, 0, tokyo),
+			to:    time.Date(2023, 1, 12, 23, 59, 59, 999999999, tokyo),
+		},
+		{
+			name:  "RFC 3339 is converted to the location",
+			start: "2023-01-01T00:00:00Z",
+			end:   "2023-01-01T05:30:00+00:00",
+			from:  time.Date(2023, 1, 1, 9, 0, 0, 0, tokyo),
+			to:    time.Date(2023, 1, 1, 14, 30, 0, 0, tokyo),
+		},
+		{
+			name:  "local date-times",
+			start: "2023-01-01T09:00",
+			end:   "2023-01-01 17:30:15",
+			from:  time.Date(2023, 1, 1, 9, 0, 0, 0, tokyo),
+			to:    time.Date(2023, 1, 1, 17, 30, 15, 0, tokyo),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, err := ParseRange(tt.start, tt.end, tokyo)
+			if err != nil {
+				t.Fatalf("ParseRange() error = %v", err)
+			}
+			if !from.Equal(tt.from) || !to.Equal(tt.to) || from.Location() != tokyo || to.Location() != tokyo {
+				t.Errorf("ParseRange() = %v, %v, want %v, %v", from, to, tt.from, tt.to)
+			}
+		})
+	}
+
+	for _, r := range [][2]string{{"2023-01-12", "2023-01-01"}, {"2023-01-01T10:00", "2023-01-01T09:00"}, {"x", "2023-01-01"}} {
+		if _, _, err := ParseRange(r[0], r[1], tokyo); err == nil {
+			t.Errorf("ParseRange(%q, %q) error = nil, want an error", r[0], r[1])
+		}
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		interval string
+		from     time.Time
+		to       time.Time
+	}{
+		{
+			interval: "2023-01-01/2023-01-12",
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 12, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			interval: "2023-01-01/2023-02",
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 2, 28, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			interval: "2023-01-01/12",
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 12, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			interval: "2023-01-30/02-02",
+			from:     time.Date(2023, 1, 30, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 2, 2, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			interval: "2023-01-01T09:00/11:00",
+			from:     time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 1, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			interval: "2023-01-01T09:00+09:00/02T11:00",
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 2, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			interval: "2023-01/03",
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 3, 31, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			interval: "2022/2023",
+			from:     time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 12, 31, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			interval: "2023-01-01T09:00/PT2H",
+			from:     time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 1, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			interval: "2023-01-01/P1W",
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 7, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			interval: "2023-01-31/P1M",
+			from:     time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 3, 2, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			interval: "P1W/2023-03-26",
+			from:     time.Date(2023, 3, 20, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 3, 26, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			interval: "PT90M/2023-03-26T12:00:00Z",
+			from:     time.Date(2023, 3, 26, 10, 30, 0, 0, time.UTC),
+			to:       time.Date(2023, 3, 26, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.interval, func(t *testing.T) {
+			from, to, err := ParseInterval(tt.interval, time.UTC)
+			if err != nil {
+				t.Fatalf("ParseInterval() error = %v", err)
+			}
+			if !from.Equal(tt.from) || !to.Equal(tt.to) {
+				t.Errorf("ParseInterval() = %v, %v, want %v, %v", from, to, tt.from, tt.to)
+			}
+		})
+	}
+
+	for _, interval := range []string{"2023-01-01", "2023-01-01/tomorrow", "yesterday/2023-01-01", "P1D/P2D", "2023-01-12/01", "2023-01-01/P1X"} {
+		if _, _, err := ParseInterval(interval, time.UTC); err == nil {
+			t.Errorf("ParseInterval(%q) error = nil, want an error", interval)
+		}
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	period, err := ParsePeriod("P1Y2M3W4DT5H6M7S")
+	if err != nil {
+		t.Fatalf("ParsePeriod() error = %v", err)
+	}
+	expected := Period{Years: 1, Months: 2, Days: 25, Duration: 5*time.Hour + 6*time.Minute + 7*time.Second}
+	if period != expected {
+		t.Errorf("ParsePeriod() = %+v, want %+v", period, expected)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{value: "P1W", expected: 7 * 24 * time.Hour},
+		{value: "P1D", expected: 24 * time.Hour},
+		{value: "PT1H30M", expected: 90 * time.Minute},
+		{value: "P1DT12H", expected: 36 * time.Hour},
+		{value: "PT45S", expected: 45 * time.Second},
+		{value: "-PT15M", expected: -15 * time.Minute},
+		{value: "pt2h", expected: 2 * time.Hour},
+		{value: "P", wantErr: true},
+		{value: "PT", wantErr: true},
+		{value: "P1M", wantErr: true},
+		{value: "PT1D", wantErr: true},
+		{value: "P1H", wantErr: true},
+		{value: "1H", wantErr: true},
+		{value: "PTH", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			d, err := ParseDuration(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDuration() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if d != tt.expected {
+				t.Errorf("ParseDuration() = %v, want %v", d, tt.expected)
+			}
+		})
+	}
+}
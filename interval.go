@@ -0,0 +1,143 @@
+package littledate
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hnq90/little-date-go/internal/timeparse"
+)
+
+// ParseInterval parses an ISO 8601 interval in one of the forms START/END,
+// START/DURATION and DURATION/END, such as "2023-01-01/2023-01-12",
+// "2023-01-01T09:00/PT2H" or "P1W/2023-03-26". Abbreviated ends like
+// "2023-01-01/12" and "2023-01-01T09:00/11:00", as written by
+// FormatISOInterval, are accepted too.
+//
+// Values without a UTC offset are interpreted in location. A date includes
+// its whole day, and a month ("2023-01") or year ("2023") its whole month or
+// year.
+func ParseInterval(interval string, location *time.Location) (DateRange, error) {
+	from, to, err := timeparse.ParseInterval(interval, location)
+	if err != nil {
+		return DateRange{}, fmt.Errorf("littledate: invalid interval: %w", err)
+	}
+	return DateRange{From: from, To: to}, nil
+}
+
+// FormatISOInterval formats a range as an abbreviated ISO 8601 interval. It
+// collapses the same fields as FormatDateRange: fields the end shares with
+// the start are left out, whole days are written as dates and whole months
+// and years with reduced precision. Times are only written if
+// options.IncludeTime is set, in the local time of options.Location without
+// a UTC offset.
+//
+// Examples:
+// - 2023-01-01/12
+// - 2023-01-01T09:00/11:00
+// - 2023-01/03
+// - 2023/2023
+func FormatISOInterval(from, to time.Time, options DateRangeFormatOptions) string {
+	var buf [64]byte
+	return string(appendISOInterval(buf[:0], from, to, options))
+}
+
+// FormatISOInterval formats a range as an abbreviated ISO 8601 interval, see FormatISOInterval.
+func (f Formatter) FormatISOInterval(from, to time.Time) string {
+	return FormatISOInterval(from, to, f.Options)
+}
+
+// appendISOInterval renders the abbreviated interval into b
+func appendISOInterval(b []byte, from, to time.Time, options DateRangeFormatOptions) []byte {
+	setDefaults(&options)
+	if options.Location != nil {
+		from = from.In(options.Location)
+		to = to.In(options.Location)
+	}
+
+	sameYear := from.Year() == to.Year()
+	sameMonth := sameYear && from.Month() == to.Month()
+	sameDay := sameMonth && from.Day() == to.Day()
+
+	// Whole years, e.g. 2023/2023
+	if isSameMinute(startOfYear(from), from) && isSameMinute(endOfYear(to), to) {
+		b = strconv.AppendInt(b, int64(from.Year()), 10)
+		b = append(b, '/')
+		return strconv.AppendInt(b, int64(to.Year()), 10)
+	}
+
+	// Whole months, e.g. 2023-01/03
+	if isSameMinute(startOfMonth(from), from) && isSameMinute(endOfMonth(to), to) {
+		b = appendISOMonth(b, from)
+		b = append(b, '/')
+		if sameYear {
+			return appendTwoDigits(b, int(to.Month()))
+		}
+		return appendISOMonth(b, to)
+	}
+
+	wholeDays := isSameMinute(startOfDay(from), from) && isSameMinute(endOfDay(to), to)
+	if !options.IncludeTime || wholeDays {
+		// Days, e.g. 2023-01-01/12
+		b = appendISODate(b, from)
+		b = append(b, '/')
+		switch {
+		case sameMonth:
+			return appendTwoDigits(b, to.Day())
+		case sameYear:
+			b = appendTwoDigits(b, int(to.Month()))
+			b = append(b, '-')
+			return appendTwoDigits(b, to.Day())
+		default:
+			return appendISODate(b, to)
+		}
+	}
+
+	// Times, e.g. 2023-01-01T09:00/11:00
+	seconds := from.Second() != 0 || to.Second() != 0
+	b = appendISODate(b, from)
+	b = append(b, 'T')
+	b = appendISOTime(b, from, seconds)
+	b = append(b, '/')
+	switch {
+	case sameDay:
+	case sameMonth:
+		b = appendTwoDigits(b, to.Day())
+		b = append(b, 'T')
+	case sameYear:
+		b = appendTwoDigits(b, int(to.Month()))
+		b = append(b, '-')
+		b = appendTwoDigits(b, to.Day())
+		b = append(b, 'T')
+	default:
+		b = appendISODate(b, to)
+		b = append(b, 'T')
+	}
+	return appendISOTime(b, to, seconds)
+}
+
+// appendISOMonth appends a month, e.g. "2023-01"
+func appendISOMonth(b []byte, t time.Time) []byte {
+	b = strconv.AppendInt(b, int64(t.Year()), 10)
+	b = append(b, '-')
+	return appendTwoDigits(b, int(t.Month()))
+}
+
+// appendISODate appends a date, e.g. "2023-01-01"
+func appendISODate(b []byte, t time.Time) []byte {
+	b = appendISOMonth(b, t)
+	b = append(b, '-')
+	return appendTwoDigits(b, t.Day())
+}
+
+// appendISOTime appends a time, e.g. "09:00" or "09:00:30"
+func appendISOTime(b []byte, t time.Time, seconds bool) []byte {
+	b = appendTwoDigits(b, t.Hour())
+	b = append(b, ':')
+	b = appendTwoDigits(b, t.Minute())
+	if seconds {
+		b = append(b, ':')
+		b = appendTwoDigits(b, t.Second())
+	}
+	return b
+}
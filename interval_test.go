@@ -0,0 +1,118 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatISOInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		expected string
+	}{
+		{
+			name:     "days in one month",
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 12, 23, 59, 59, 999999999, time.UTC),
+			expected: "2023-01-01/12",
+		},
+		{
+			name:     "days across months",
+			from:     time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 4, 20, 23, 59, 59, 999999999, time.UTC),
+			expected: "2023-01-03/04-20",
+		},
+		{
+			name:     "days across years",
+			from:     time.Date(2022, 12, 30, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 2, 23, 59, 59, 999999999, time.UTC),
+			expected: "2022-12-30/2023-01-02",
+		},
+		{
+			name:     "single day",
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 1, 23, 59, 59, 999999999, time.UTC),
+			expected: "2023-01-01/01",
+		},
+		{
+			name:     "whole month",
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 31, 23, 59, 59, 999999999, time.UTC),
+			expected: "2023-01/01",
+		},
+		{
+			name:     "quarter",
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 3, 31, 23, 59, 59, 999999999, time.UTC),
+			expected: "2023-01/03",
+		},
+		{
+			name:     "whole year",
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 12, 31, 23, 59, 59, 999999999, time.UTC),
+			expected: "2023/2023",
+		},
+		{
+			name:     "times on one day",
+			from:     time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 1, 11, 0, 0, 0, time.UTC),
+			expected: "2023-01-01T09:00/11:00",
+		},
+		{
+			name:     "times across days",
+			from:     time.Date(2023, 1, 1, 22, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 2, 1, 30, 15, 0, time.UTC),
+			expected: "2023-01-01T22:00:00/02T01:30:15",
+		},
+		{
+			name:     "times across years",
+			from:     time.Date(2022, 12, 31, 22, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 1, 2, 0, 0, 0, time.UTC),
+			expected: "2022-12-31T22:00/2023-01-01T02:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatISOInterval(tt.from, tt.to, defaultOptions)
+			if result != tt.expected {
+				t.Errorf("FormatISOInterval() = %v, want %v", result, tt.expected)
+			}
+
+			// The abbreviated form parses back into the same range
+			r, err := ParseInterval(result, time.UTC)
+			if err != nil {
+				t.Fatalf("ParseInterval() error = %v", err)
+			}
+			if !isSameMinute(r.From, tt.from) || !isSameMinute(r.To, tt.to) {
+				t.Errorf("ParseInterval() = %v, want %v - %v", r, tt.from, tt.to)
+			}
+		})
+	}
+}
+
+func TestFormatISOIntervalWithoutTime(t *testing.T) {
+	from := time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 1, 1, 11, 0, 0, 0, time.UTC)
+	options := DateRangeFormatOptions{Today: today, Location: time.FixedZone("UTC-10", -10*60*60)}
+
+	if result := FormatISOInterval(from, to, options); result != "2022-12-31/2023-01-01" {
+		t.Errorf("FormatISOInterval() = %v, want 2022-12-31/2023-01-01", result)
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	r, err := ParseInterval("P1W/2023-03-26", time.UTC)
+	if err != nil {
+		t.Fatalf("ParseInterval() error = %v", err)
+	}
+	if result := r.Format(defaultOptions); result != "Mar 20 - 26" {
+		t.Errorf("ParseInterval() = %v, want Mar 20 - 26", result)
+	}
+
+	if _, err := ParseInterval("2023-01-01", time.UTC); err == nil {
+		t.Errorf("ParseInterval() error = nil, want an error")
+	}
+}
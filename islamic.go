@@ -0,0 +1,201 @@
+package littledate
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+// IslamicCivil is the tabular Islamic (Hijri) calendar. Months alternate
+// between 30 and 29 days, and 11 years of every 30-year cycle have an extra
+// day at the end of Dhuʻl-Hijjah. It starts on Friday, July 16, 622 (Julian).
+// Its months can differ by a day or two from those announced by sighting
+// the moon.
+//
+// Examples:
+// - Ram. 1 - 10, 1443
+// - Ramadan 1444
+// - ١ - ١٠ رمضان
+var IslamicCivil Calendar = islamic{}
+
+// IslamicUmmAlQura is the Hijri calendar of Saudi Arabia. Its months follow
+// the Umm al-Qura tables for the years 1300 to 1600 AH (1882 to 2174), and
+// those of IslamicCivil outside of them.
+var IslamicUmmAlQura Calendar = islamic{ummAlQura: true}
+
+// islamicEpoch is 1 Muharram 1 AH of the tabular calendar, in days since January 1, 1970
+const islamicEpoch = -492148
+
+// islamicMonths are the English month names, used when a locale has none
+var islamicMonths = [12]string{
+	"Muharram", "Safar", "Rabiʻ I", "Rabiʻ II", "Jumada I", "Jumada II",
+	"Rajab", "Shaʻban", "Ramadan", "Shawwal", "Dhuʻl-Qiʻdah", "Dhuʻl-Hijjah",
+}
+
+// islamicShortMonths are the English abbreviated month names
+var islamicShortMonths = [12]string{
+	"Muh.", "Saf.", "Rab. I", "Rab. II", "Jum. I", "Jum. II",
+	"Raj.", "Sha.", "Ram.", "Shaw.", "Dhuʻl-Q.", "Dhuʻl-H.",
+}
+
+type islamic struct {
+	ummAlQura bool
+}
+
+func (c islamic) Date(t time.Time) (int, int, int) {
+	days := epochDays(t)
+	if c.ummAlQura {
+		if year, month, day, ok := ummAlQuraDate(days); ok {
+			return year, month, day
+		}
+	}
+	return tabularIslamicDate(days)
+}
+
+func (c islamic) Time(year, month, day int, loc *time.Location) time.Time {
+	if c.ummAlQura {
+		if start, ok := ummAlQuraMonthStart(year, month); ok {
+			return fromEpochDays(start+day-1, loc)
+		}
+	}
+	return fromEpochDays(tabularIslamicDays(year, month, day), loc)
+}
+
+func (c islamic) MonthsInYear(year int) int {
+	return 12
+}
+
+func (c islamic) DaysInMonth(year, month int) int {
+	if c.ummAlQura {
+		if index := year - ummAlQuraFirstYear; index >= 0 && index < len(ummAlQuraMonths) {
+			return ummAlQuraMonthLength(index, month)
+		}
+	}
+	if month%2 == 1 || (month == 12 && isTabularIslamicLeapYear(year)) {
+		return 30
+	}
+	return 29
+}
+
+func (c islamic) monthName(locale *localeData, year, month int, long bool) string {
+	if long {
+		return localizedMonthName(locale, "islamic", strconv.Itoa(month), true, islamicMonths[month-1])
+	}
+	return localizedMonthName(locale, "islamic", strconv.Itoa(month), false, islamicShortMonths[month-1])
+}
+
+// isTabularIslamicLeapYear reports whether Dhuʻl-Hijjah has 30 days in a tabular year
+func isTabularIslamicLeapYear(year int) bool {
+	return ((14+11*year)%30+30)%30 < 11
+}
+
+// tabularIslamicDays returns the day number of a tabular Hijri date
+func tabularIslamicDays(year, month, day int) int {
+	return islamicEpoch + (year-1)*354 + floorDiv(3+11*year, 30) + (59*(month-1)+1)/2 + day - 1
+}
+
+// tabularIslamicDate converts a day number to a tabular Hijri date
+func tabularIslamicDate(days int) (int, int, int) {
+	year := floorDiv(30*(days-islamicEpoch)+10646, 10631)
+	for tabularIslamicDays(year+1, 1, 1) <= days {
+		year++
+	}
+	for tabularIslamicDays(year, 1, 1) > days {
+		year--
+	}
+	month := 12
+	for month > 1 && tabularIslamicDays(year, month, 1) > days {
+		month--
+	}
+	return year, month, days - tabularIslamicDays(year, month, 1) + 1
+}
+
+// ummAlQuraFirstYear is the first year of ummAlQuraMonths
+const ummAlQuraFirstYear = 1300
+
+// ummAlQuraEpoch is 1 Muharram 1300 AH (November 12, 1882), in days since January 1, 1970
+const ummAlQuraEpoch = -31826
+
+// ummAlQuraMonths holds the month lengths of the Umm al-Qura calendar from
+// 1300 AH, one entry per year. Bit m-1 is set if month m has 30 days.
+var ummAlQuraMonths = [...]uint16{
+	0x555, 0x2ab, 0x937, 0x2b6, 0x576, 0x36c, 0xb55, 0xaaa, 0x956, 0x49e,
+	0x95d, 0x2ba, 0x5b5, 0x3aa, 0xb4b, 0xa96, 0x52e, 0x2ad, 0x56d, 0xb5a,
+	0x752, 0xf25, 0xe8a, 0xd16, 0xa56, 0xab5, 0x6b4, 0xda9, 0xb92, 0xb25,
+	0x64b, 0xa9b, 0x35a, 0x6d9, 0x5d4, 0xda5, 0xd4a, 0xa95, 0x536, 0x975,
+	0x2f4, 0x6e9, 0x6d4, 0x6a9, 0x535, 0x25d, 0x4bd, 0x9ba, 0x3b4, 0xb69,
+	0xb2a, 0xa55, 0x4ad, 0xa5d, 0x2da, 0x6d9, 0xeaa, 0xe94, 0xd2a, 0xc56,
+	0x4ae, 0xa6d, 0x56a, 0xd55, 0xd4a, 0xa93, 0x52b, 0xa5b, 0x53a, 0x6b5,
+	0xea9, 0xd52, 0xd29, 0xa55, 0x4ad, 0x56d, 0xaea, 0x6e4, 0xed1, 0xda2,
+	0xaaa, 0x95a, 0x2da, 0x5b9, 0xbb2, 0x764, 0x6c9, 0x555, 0x2ab, 0x4db,
+	0xaba, 0x5b4, 0xda9, 0xd52, 0xaa5, 0x92d, 0x26d, 0x8ed, 0x2da, 0xad5,
+	0xaa5, 0xa4b, 0x497, 0x937, 0x2b6, 0x975, 0xd69, 0xd52, 0xc95, 0x92b,
+	0x25b, 0x4db, 0x9d5, 0x5d2, 0xda5, 0xd4a, 0xa95, 0x54d, 0xaad, 0x3aa,
+	0xbd2, 0xbc4, 0xb89, 0xa95, 0x52d, 0x5ad, 0xb6a, 0x6d4, 0xdc9, 0xd92,
+	0xaa6, 0x956, 0x2ae, 0x56d, 0x36a, 0xb55, 0xaaa, 0x94d, 0x49d, 0x95d,
+	0x2ba, 0x5b5, 0x5aa, 0xd55, 0xa9a, 0x92e, 0x26e, 0x55d, 0xada, 0x6d4,
+	0x6a5, 0xb27, 0xa4d, 0x4ad, 0x56d, 0xb5a, 0x754, 0xf49, 0xe92, 0xd26,
+	0xa56, 0x356, 0x6b5, 0xbaa, 0xb92, 0xb25, 0x68b, 0xa9b, 0x55a, 0xada,
+	0x5b4, 0xda9, 0xb52, 0xa9a, 0x536, 0x276, 0x575, 0xaf2, 0x6d4, 0x6a9,
+	0x555, 0x2ad, 0x4bd, 0x9ba, 0x574, 0xb69, 0xb52, 0xa95, 0x52d, 0xa5d,
+	0x4da, 0xad9, 0x6b2, 0xe95, 0xe2a, 0xc96, 0x92e, 0xaad, 0x56a, 0xd65,
+	0xd4a, 0xd15, 0x62b, 0xc5b, 0x53a, 0x6b5, 0xdb2, 0xd64, 0xd29, 0xa55,
+	0x4ad, 0x96d, 0xaea, 0x6e8, 0xed1, 0xda4, 0xd4a, 0xa6a, 0x2da, 0x5b9,
+	0xb72, 0xb68, 0x6d1, 0x655, 0x4ab, 0x95b, 0x2ba, 0x5b5, 0xda9, 0xd52,
+	0xca6, 0x94e, 0x46e, 0x95d, 0x4da, 0xad5, 0xaaa, 0xa4d, 0x49b, 0x937,
+	0x4b6, 0x975, 0xd6a, 0xd52, 0xaa5, 0x94b, 0x2ab, 0x55b, 0xad9, 0x5d2,
+	0xdc5, 0xd92, 0xb25, 0x555, 0xab5, 0x5b4, 0xba9, 0x7a2, 0x745, 0x593,
+	0xaab, 0x4d6, 0x9d6, 0x5d2, 0xba5, 0xb4a, 0xa95, 0x4ad, 0x15d, 0x2dd,
+	0x9da, 0x5b4, 0x5a9, 0x52d, 0x25b, 0x8b7, 0x176, 0x56d, 0xb6a, 0xaca,
+	0xa96, 0x52b, 0x15b, 0x2bb, 0x5b6, 0xdaa, 0xb94, 0xd46, 0xa8d, 0x52d,
+	0xa9d, 0x55a, 0x755, 0x749, 0xf13, 0xe4a, 0xa96, 0x556, 0x6b5, 0xbaa,
+	0xb94,
+}
+
+// ummAlQuraYearStarts holds the day number of 1 Muharram of every year in
+// ummAlQuraMonths, followed by the day after the last year
+var ummAlQuraYearStarts = func() []int {
+	starts := make([]int, len(ummAlQuraMonths)+1)
+	starts[0] = ummAlQuraEpoch
+	for i := range ummAlQuraMonths {
+		starts[i+1] = starts[i]
+		for month := 1; month <= 12; month++ {
+			starts[i+1] += ummAlQuraMonthLength(i, month)
+		}
+	}
+	return starts
+}()
+
+func ummAlQuraMonthLength(index, month int) int {
+	return 29 + int(ummAlQuraMonths[index]>>(month-1)&1)
+}
+
+// ummAlQuraMonthStart returns the day number of the first day of a month,
+// if the year is in the table
+func ummAlQuraMonthStart(year, month int) (int, bool) {
+	index := year - ummAlQuraFirstYear
+	if index < 0 || index >= len(ummAlQuraMonths) {
+		return 0, false
+	}
+	start := ummAlQuraYearStarts[index]
+	for m := 1; m < month; m++ {
+		start += ummAlQuraMonthLength(index, m)
+	}
+	return start, true
+}
+
+// ummAlQuraDate converts a day number to an Umm al-Qura date, if it is in the table
+func ummAlQuraDate(days int) (int, int, int, bool) {
+	starts := ummAlQuraYearStarts
+	if days < starts[0] || days >= starts[len(starts)-1] {
+		return 0, 0, 0, false
+	}
+	index := sort.SearchInts(starts, days+1) - 1
+	day := days - starts[index] + 1
+	month := 1
+	for day > ummAlQuraMonthLength(index, month) {
+		day -= ummAlQuraMonthLength(index, month)
+		month++
+	}
+	return ummAlQuraFirstYear + index, month, day, true
+}
@@ -0,0 +1,76 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIslamicCalendar(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	end := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+	}
+	ummAlQura := DateRangeFormatOptions{Today: today, Locale: "en_US", Calendar: IslamicUmmAlQura}
+	civil := DateRangeFormatOptions{Today: today, Locale: "en_US", Calendar: IslamicCivil}
+	arabic := DateRangeFormatOptions{Today: today, Locale: "ar", Calendar: IslamicUmmAlQura}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		options  DateRangeFormatOptions
+		expected string
+	}{
+		{"days", date(2023, 3, 23), end(2023, 4, 1), ummAlQura, "Ram. 1 - 10, 1444"},
+		{"full month", date(2023, 3, 23), end(2023, 4, 20), ummAlQura, "Ramadan 1444"},
+		{"Gregorian month is not a full month", date(2023, 3, 1), end(2023, 3, 31), ummAlQura, "Sha. 9 - Ram. 9, 1444"},
+		{"full month tabular", date(2023, 3, 23), end(2023, 4, 21), civil, "Ramadan 1444"},
+		{"full year", date(2022, 7, 30), end(2023, 7, 18), ummAlQura, "1444"},
+		{"this year", date(2023, 11, 15), end(2023, 11, 16), ummAlQura, "Jum. I 1 - 2"},
+		{"across years", date(2023, 7, 10), end(2023, 7, 20), ummAlQura, "Dhuʻl-H. 22 '44 - Muh. 2 '45"},
+		{"arabic", date(2023, 3, 23), end(2023, 4, 20), arabic, "رمضان ١٤٤٤"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDateRange(tt.from, tt.to, tt.options)
+			if result != tt.expected {
+				t.Errorf("FormatDateRange() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIslamicConversion(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar Calendar
+		date     time.Time
+		year     int
+		month    int
+		day      int
+	}{
+		{"epoch", IslamicCivil, time.Date(622, 7, 19, 0, 0, 0, 0, time.UTC), 1, 1, 1},
+		{"civil", IslamicCivil, time.Date(2023, 3, 23, 0, 0, 0, 0, time.UTC), 1444, 9, 1},
+		{"civil leap day", IslamicCivil, time.Date(2024, 7, 7, 0, 0, 0, 0, time.UTC), 1445, 12, 30},
+		{"umm al-qura", IslamicUmmAlQura, time.Date(2023, 4, 21, 0, 0, 0, 0, time.UTC), 1444, 10, 1},
+		{"first table year", IslamicUmmAlQura, time.Date(1882, 11, 12, 0, 0, 0, 0, time.UTC), 1300, 1, 1},
+		{"after the table", IslamicUmmAlQura, time.Date(2200, 1, 1, 0, 0, 0, 0, time.UTC), 1626, 11, 14},
+		{"local date", IslamicUmmAlQura, time.Date(2023, 3, 23, 23, 30, 0, 0, time.FixedZone("UTC+3", 3*3600)), 1444, 9, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, month, day := tt.calendar.Date(tt.date)
+			if year != tt.year || month != tt.month || day != tt.day {
+				t.Errorf("Date() = %d-%d-%d, want %d-%d-%d", year, month, day, tt.year, tt.month, tt.day)
+			}
+			start := tt.calendar.Time(year, month, day, tt.date.Location())
+			if !start.Equal(startOfDay(tt.date)) {
+				t.Errorf("Time() = %v, want %v", start, startOfDay(tt.date))
+			}
+		})
+	}
+}
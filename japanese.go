@@ -0,0 +1,242 @@
+package littledate
+
+import (
+	"time"
+)
+
+// JapaneseEra writes dates with the year of the Japanese imperial era, in
+// the layout used by Japanese government and business documents. The first
+// year of an era is written as 元年, a range crossing the start of an era
+// names both eras and times are written on the 24-hour clock.
+//
+// Examples:
+// - 令和5年1月1日～12日
+// - 令和5年1月30日～2月2日
+// - 平成31年4月29日～令和元年5月2日
+// - 令和5年第1四半期
+var JapaneseEra Calendar = japanese{}
+
+// JapaneseEraShort is JapaneseEra with abbreviated era names and numeric
+// dates.
+//
+// Examples:
+// - R5/1/1～1/12
+// - H31/4/29～R1/5/2
+// - R5/1
+var JapaneseEraShort Calendar = japanese{short: true}
+
+// japanese counts months and days like the Gregorian calendar, which Japan
+// follows since 1873. Only the way years are written differs.
+type japanese struct {
+	gregorian
+	short bool
+}
+
+// era is a Japanese imperial era starting on a Gregorian date
+type era struct {
+	year, month, day int
+	name             string
+	letter           byte
+}
+
+// eras lists the modern eras from the oldest to the newest
+var eras = []era{
+	{1868, 10, 23, "明治", 'M'},
+	{1912, 7, 30, "大正", 'T'},
+	{1926, 12, 25, "昭和", 'S'},
+	{1989, 1, 8, "平成", 'H'},
+	{2019, 5, 1, "令和", 'R'},
+}
+
+// eraOf returns the index of the era of t and the year within it. The index
+// is -1 for dates before the Meiji era.
+func eraOf(t time.Time) (int, int) {
+	year, month, day := t.Date()
+	for i := len(eras) - 1; i >= 0; i-- {
+		e := eras[i]
+		if year > e.year || (year == e.year && (int(month) > e.month || (int(month) == e.month && day >= e.day))) {
+			return i, year - e.year + 1
+		}
+	}
+	return -1, year
+}
+
+func (j japanese) monthName(locale *localeData, year, month int, long bool) string {
+	return string(appendNumber(nil, month, locale.digits)) + "月"
+}
+
+// appendYear writes the era and year of t, e.g. "令和5年" or "R5"
+func (j japanese) appendYear(b []byte, t time.Time, system NumberingSystem) []byte {
+	index, year := eraOf(t)
+	if j.short {
+		if index >= 0 {
+			b = append(b, eras[index].letter)
+		}
+		return appendNumber(b, year, system)
+	}
+
+	if index >= 0 {
+		b = append(b, eras[index].name...)
+		if year == 1 {
+			return append(b, "元年"...)
+		}
+	}
+	b = appendNumber(b, year, system)
+	return append(b, "年"...)
+}
+
+// appendMonth writes a month, e.g. "1月", or "1" in the short style
+func (j japanese) appendMonth(b []byte, month int, system NumberingSystem) []byte {
+	b = appendNumber(b, month, system)
+	if j.short {
+		return b
+	}
+	return append(b, "月"...)
+}
+
+// appendDay writes the day of t, e.g. "1日", or "1" in the short style
+func (j japanese) appendDay(b []byte, t time.Time, system NumberingSystem) []byte {
+	b = appendNumber(b, t.Day(), system)
+	if j.short {
+		return b
+	}
+	return append(b, "日"...)
+}
+
+// appendFullDate writes the era year, month and day, e.g. "令和5年1月1日" or "R5/1/1"
+func (j japanese) appendFullDate(b []byte, t time.Time, system NumberingSystem) []byte {
+	b = j.appendYear(b, t, system)
+	if j.short {
+		b = append(b, '/')
+	}
+	return j.appendMonthDay(b, t, system)
+}
+
+// appendMonthDay writes the month and day, e.g. "1月1日" or "1/1"
+func (j japanese) appendMonthDay(b []byte, t time.Time, system NumberingSystem) []byte {
+	b = j.appendMonth(b, int(t.Month()), system)
+	if j.short {
+		b = append(b, '/')
+	}
+	return j.appendDay(b, t, system)
+}
+
+// sameEraYear reports whether two times lie in the same year of the same era
+func sameEraYear(t1, t2 time.Time) bool {
+	i1, y1 := eraOf(t1)
+	i2, y2 := eraOf(t2)
+	return i1 == i2 && y1 == y2
+}
+
+func (j japanese) appendRange(b []byte, from, to time.Time, options DateRangeFormatOptions, locale *localeData) []byte {
+	// The wave dash is the usual range mark; other separators keep their spaces
+	appendSeparator := func(b []byte) []byte {
+		if options.Separator == "-" {
+			return append(b, "～"...)
+		}
+		b = append(b, ' ')
+		b = append(b, options.Separator...)
+		return append(b, ' ')
+	}
+
+	var startTime, endTime bool
+	if options.IncludeTime {
+		startTime = !isSameMinute(startOfDay(from), from)
+		endTime = !isSameMinute(endOfDay(to), to)
+	}
+	oneDay := sameDay(from, to)
+	sameYear := sameEraYear(from, to)
+
+	// Same day, different times
+	// Example: 令和5年1月1日 9:00～10:00, or 9:00～10:00 today
+	if oneDay && (startTime || endTime) {
+		if !sameDay(from, options.Today) {
+			b = j.appendFullDate(b, from, locale.digits)
+			b = append(b, ' ')
+		}
+		b = appendTime(b, from, true, locale.digits)
+		b = appendSeparator(b)
+		return appendTime(b, to, true, locale.digits)
+	}
+
+	// Ranges of whole months, quarters and years within one era year
+	// Example: 令和5年, 令和5年第1四半期, 令和5年1月～2月
+	if !startTime && !endTime && isSameMinute(startOfMonth(from), from) && isSameMinute(endOfMonth(to), to) {
+		if sameYear && isSameMinute(startOfYear(from), from) && isSameMinute(endOfYear(to), to) {
+			return j.appendYear(b, from, locale.digits)
+		}
+		if !j.short && sameYear && isCalendarQuarter(j, from, to) {
+			quarter, _ := calendarQuarter(j, from)
+			b = j.appendYear(b, from, locale.digits)
+			b = append(b, "第"...)
+			b = appendNumber(b, quarter, locale.digits)
+			return append(b, "四半期"...)
+		}
+
+		b = j.appendYear(b, from, locale.digits)
+		if j.short {
+			b = append(b, '/')
+		}
+		b = j.appendMonth(b, int(from.Month()), locale.digits)
+		// A month of two eras, such as January 1989, names both
+		if sameYear && from.Month() == to.Month() {
+			return b
+		}
+		b = appendSeparator(b)
+		if !sameYear {
+			b = j.appendYear(b, to, locale.digits)
+			if j.short {
+				b = append(b, '/')
+			}
+		}
+		return j.appendMonth(b, int(to.Month()), locale.digits)
+	}
+
+	// Full day
+	// Example: 令和5年1月1日(日)
+	if oneDay {
+		b = j.appendFullDate(b, from, locale.digits)
+		b = append(b, '(')
+		b = append(b, locale.names.shortWeekdays[from.Weekday()]...)
+		return append(b, ')')
+	}
+
+	// Range across days, written in full up to the first part that differs
+	// Example: 令和5年1月1日～12日, 平成31年4月29日～令和元年5月2日
+	b = j.appendFullDate(b, from, locale.digits)
+	if startTime {
+		b = append(b, ' ')
+		b = appendTime(b, from, true, locale.digits)
+	}
+	b = appendSeparator(b)
+	switch {
+	case !sameYear:
+		b = j.appendFullDate(b, to, locale.digits)
+	case j.short || from.Month() != to.Month() || startTime || endTime:
+		b = j.appendMonthDay(b, to, locale.digits)
+	default:
+		b = j.appendDay(b, to, locale.digits)
+	}
+	if endTime {
+		b = append(b, ' ')
+		b = appendTime(b, to, true, locale.digits)
+	}
+	return b
+}
+
+func (j japanese) appendDate(b []byte, date time.Time, options DateRangeFormatOptions, locale *localeData) []byte {
+	if options.IncludeTime && !isSameMinute(startOfDay(date), date) {
+		// If it's today, don't include the date
+		if !sameDay(date, options.Today) {
+			b = j.appendFullDate(b, date, locale.digits)
+			b = append(b, ' ')
+		}
+		return appendTime(b, date, true, locale.digits)
+	}
+
+	// Example: 令和5年1月1日(日)
+	b = j.appendFullDate(b, date, locale.digits)
+	b = append(b, '(')
+	b = append(b, locale.names.shortWeekdays[date.Weekday()]...)
+	return append(b, ')')
+}
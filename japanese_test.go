@@ -0,0 +1,80 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJapaneseEra(t *testing.T) {
+	date := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+	end := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+	}
+	long := DateRangeFormatOptions{Today: today, Locale: "ja", IncludeTime: true, Calendar: JapaneseEra}
+	short := long
+	short.Calendar = JapaneseEraShort
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		options  DateRangeFormatOptions
+		expected string
+	}{
+		{"days", date(2023, 1, 1, 0, 0), end(2023, 1, 12), long, "令和5年1月1日～12日"},
+		{"across months", date(2023, 1, 30, 0, 0), end(2023, 2, 2), long, "令和5年1月30日～2月2日"},
+		{"era transition", date(2019, 4, 29, 0, 0), end(2019, 5, 2), long, "平成31年4月29日～令和元年5月2日"},
+		{"first year", date(2019, 5, 1, 0, 0), end(2019, 5, 1), long, "令和元年5月1日(水)"},
+		{"full year", date(2023, 1, 1, 0, 0), end(2023, 12, 31), long, "令和5年"},
+		{"year of two eras", date(2019, 1, 1, 0, 0), end(2019, 12, 31), long, "平成31年1月～令和元年12月"},
+		{"quarter", date(2023, 1, 1, 0, 0), end(2023, 3, 31), long, "令和5年第1四半期"},
+		{"month", date(2023, 1, 1, 0, 0), end(2023, 1, 31), long, "令和5年1月"},
+		{"months", date(2023, 1, 1, 0, 0), end(2023, 2, 28), long, "令和5年1月～2月"},
+		{"month of two eras", date(1989, 1, 1, 0, 0), end(1989, 1, 31), long, "昭和64年1月～平成元年1月"},
+		{"first month of an era", date(2019, 5, 1, 0, 0), end(2019, 5, 31), long, "令和元年5月"},
+		{"times", date(2023, 1, 1, 9, 0), date(2023, 1, 1, 10, 0), long, "令和5年1月1日 9:00～10:00"},
+		{"times across days", date(2023, 1, 1, 9, 0), date(2023, 1, 2, 14, 30), long, "令和5年1月1日 9:00～1月2日 14:30"},
+		{"times today", date(2023, 11, 15, 9, 0), date(2023, 11, 15, 10, 0), long, "9:00～10:00"},
+		{"before Meiji", date(1850, 3, 1, 0, 0), end(1850, 3, 3), long, "1850年3月1日～3日"},
+		{"custom separator", date(2023, 1, 1, 0, 0), end(2023, 1, 12), DateRangeFormatOptions{Today: today, Locale: "ja", Separator: "to", Calendar: JapaneseEra}, "令和5年1月1日 to 12日"},
+		{"short days", date(2023, 1, 1, 0, 0), end(2023, 1, 12), short, "R5/1/1～1/12"},
+		{"short era transition", date(2019, 4, 29, 0, 0), end(2019, 5, 2), short, "H31/4/29～R1/5/2"},
+		{"short month", date(2023, 1, 1, 0, 0), end(2023, 1, 31), short, "R5/1"},
+		{"short month of two eras", date(1989, 1, 1, 0, 0), end(1989, 1, 31), short, "S64/1～H1/1"},
+		{"short first month of an era", date(2019, 5, 1, 0, 0), end(2019, 5, 31), short, "R1/5"},
+		{"short quarter", date(2023, 1, 1, 0, 0), end(2023, 3, 31), short, "R5/1～3"},
+		{"short full year", date(1989, 1, 8, 0, 0), end(1989, 12, 31), short, "H1/1/8～12/31"},
+		{"short Showa", date(1988, 1, 1, 0, 0), end(1988, 12, 31), short, "S63"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDateRange(tt.from, tt.to, tt.options)
+			if result != tt.expected {
+				t.Errorf("FormatDateRange() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestJapaneseEraDates(t *testing.T) {
+	options := DateRangeFormatOptions{Today: today, Locale: "ja", IncludeTime: true, Calendar: JapaneseEra}
+
+	if got := FormatDate(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), options); got != "令和5年1月1日(日)" {
+		t.Errorf("FormatDate() = %v, want %v", got, "令和5年1月1日(日)")
+	}
+	if got := FormatDate(time.Date(2023, 1, 1, 14, 30, 0, 0, time.UTC), options); got != "令和5年1月1日 14:30" {
+		t.Errorf("FormatDate() = %v, want %v", got, "令和5年1月1日 14:30")
+	}
+
+	days := []time.Time{
+		time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2019, 5, 3, 0, 0, 0, 0, time.UTC),
+	}
+	if got := FormatDates(days, options); got != "平成31年4月30日～令和元年5月1日、令和元年5月3日(金)" {
+		t.Errorf("FormatDates() = %v, want %v", got, "平成31年4月30日～令和元年5月1日、令和元年5月3日(金)")
+	}
+}
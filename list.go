@@ -0,0 +1,98 @@
+package littledate
+
+import (
+	"time"
+)
+
+// FormatDateRanges formats several ranges as a list joined with the
+// conjunction of the locale. If every range lies in the same year and is
+// written with a year suffix, the year is printed once at the end.
+//
+// Examples:
+// - Jan 1 - 3, Jan 5 - 7 and Tue, Jan 10
+// - Jan 1 - 3, Jan 5 - 7 and Mon, Jan 10, 2022
+// - janv. 1 - 3 et janv. 5 - 7
+func FormatDateRanges(ranges []DateRange, options DateRangeFormatOptions) string {
+	setDefaults(&options)
+	if len(ranges) == 0 {
+		return ""
+	}
+
+	year, shared := sharedYear(ranges, options.Location, calendarOf(options))
+	items := make([]string, len(ranges))
+	var buf [64]byte
+	for i, r := range ranges {
+		items[i] = string(appendRange(buf[:0], r.From, r.To, options, shared))
+	}
+
+	locale := localeOf(options)
+	b := []byte(joinList(locale, items))
+	if shared {
+		todayYear, _, _ := calendarOf(options).Date(options.Today)
+		b = appendYearSuffix(b, locale, year, year == todayYear)
+	}
+	return string(protectNumbers(b, 0, options))
+}
+
+// FormatDateRanges formats several ranges as a list, see FormatDateRanges.
+func (f Formatter) FormatDateRanges(ranges []DateRange) string {
+	return FormatDateRanges(ranges, f.Options)
+}
+
+// sharedYear reports whether all ranges lie in one year and end with a year
+// suffix, which can then be printed once for the whole list. It returns that
+// year. Calendars with a layout of their own never share a year.
+func sharedYear(ranges []DateRange, loc *time.Location, cal Calendar) (int, bool) {
+	if _, ok := cal.(rangeWriter); ok {
+		return 0, false
+	}
+	year := 0
+	for i, r := range ranges {
+		from, to := r.From, r.To
+		if loc != nil {
+			from, to = from.In(loc), to.In(loc)
+		}
+		fromYear, _, _ := cal.Date(from)
+		if !hasYearSuffix(cal, from, to) || (i > 0 && fromYear != year) {
+			return 0, false
+		}
+		year = fromYear
+	}
+	return year, true
+}
+
+// hasYearSuffix reports whether FormatDateRange writes the year of a range
+// at its end, rather than as part of a year, quarter or month
+func hasYearSuffix(cal Calendar, from, to time.Time) bool {
+	fromYear, _, _ := cal.Date(from)
+	toYear, _, _ := cal.Date(to)
+	if fromYear != toYear {
+		return false
+	}
+	if isSameMinute(startOfCalendarYear(cal, from), from) && isSameMinute(endOfCalendarYear(cal, to), to) {
+		return false
+	}
+	if isCalendarQuarter(cal, from, to) {
+		return false
+	}
+	return !(isSameMinute(startOfCalendarMonth(cal, from), from) && isSameMinute(endOfCalendarMonth(cal, to), to))
+}
+
+// joinList joins items with the list patterns of a locale
+func joinList(locale *localeData, items []string) string {
+	if len(items) == 0 {
+		return ""
+	}
+	result := items[0]
+	for i := 1; i < len(items); i++ {
+		messageID := "list.middle"
+		if i == len(items)-1 {
+			messageID = "list.end"
+		}
+		result = locale.localize(messageID, -1, map[string]interface{}{
+			"First":  result,
+			"Second": items[i],
+		})
+	}
+	return result
+}
@@ -0,0 +1,56 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDateRanges(t *testing.T) {
+	day := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	ranges := func(year int) []DateRange {
+		return []DateRange{
+			{From: day(year, 1, 1), To: endOfDay(day(year, 1, 3))},
+			{From: day(year, 1, 5), To: endOfDay(day(year, 1, 7))},
+			{From: day(year, 1, 10), To: endOfDay(day(year, 1, 10))},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		ranges   []DateRange
+		locale   string
+		expected string
+	}{
+		{name: "empty", ranges: nil, expected: ""},
+		{name: "single", ranges: ranges(2023)[:1], expected: "Jan 1 - 3"},
+		{name: "pair", ranges: ranges(2023)[:2], expected: "Jan 1 - 3 and Jan 5 - 7"},
+		{name: "this year", ranges: ranges(2023), expected: "Jan 1 - 3, Jan 5 - 7 and Tue, Jan 10"},
+		{name: "year printed once", ranges: ranges(2022), expected: "Jan 1 - 3, Jan 5 - 7 and Mon, Jan 10, 2022"},
+		{
+			name:     "different years",
+			ranges:   append(ranges(2022)[:1], ranges(2021)[0]),
+			expected: "Jan 1 - 3, 2022 and Jan 1 - 3, 2021",
+		},
+		{
+			name:     "whole month keeps its year",
+			ranges:   []DateRange{{From: day(2022, 2, 1), To: endOfMonth(day(2022, 2, 1))}, ranges(2022)[0]},
+			expected: "February 2022 and Jan 1 - 3, 2022",
+		},
+		{name: "de", ranges: ranges(2022), locale: "de", expected: "Jan 1 - 3, Jan 5 - 7 und Mo, Jan 10, 2022"},
+		{name: "fr", ranges: ranges(2023)[:2], locale: "fr", expected: "janv. 1 - 3 et janv. 5 - 7"},
+		{name: "vi", ranges: ranges(2023)[:2], locale: "vi", expected: "Th1 1 - 3 và Th1 5 - 7"},
+		{name: "zh-CN", ranges: ranges(2023), locale: "zh-CN", expected: "1月 1 - 3、1月 5 - 7和二, 1月 10"},
+		{name: "ja", ranges: ranges(2023), locale: "ja", expected: "1月 1 - 3、1月 5 - 7、火, 1月 10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := DateRangeFormatOptions{Today: today, Locale: tt.locale}
+			if result := FormatDateRanges(tt.ranges, options); result != tt.expected {
+				t.Errorf("FormatDateRanges() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -1,38 +1,19 @@
 package littledate
 
 import (
-	"encoding/json"
-	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
-	"strings"
+	"sync"
 	"time"
-
-	"github.com/nicksnyder/go-i18n/v2/i18n"
-	"golang.org/x/text/language"
 )
 
-// i18n bundle for localization
-var bundle *i18n.Bundle
-
-// Supported languages with their translation files
-var supportedLocales = []string{"en", "fr", "es", "de", "ja", "ko", "zh-CN", "zh-TW", "vi"}
-
-// Initialize the i18n bundle
-func init() {
-	// Initialize a new bundle with English as the default language
-	bundle = i18n.NewBundle(language.English)
-
-	// Set the JSON unmarshal function
-	bundle.RegisterUnmarshalFunc("json", json.Unmarshal)
-
-	// Load all translation files
-	loadTranslationFiles()
-}
+// Built-in translations are generated from the files in i18n/locales
+//go:generate go run ./internal/genlocales
 
 // loadTranslationFiles loads all JSON translation files from the i18n/locales directory
-func loadTranslationFiles() {
+func loadTranslationFiles(t *translations) {
 	// Try different paths to find the translation files
 	// This allows the library to work both when used as a dependency and when run from the repo root
 	possiblePaths := []string{
@@ -50,23 +31,45 @@ func loadTranslationFiles() {
 		}
 	}
 
-	// If no translation directory was found, use embedded translations instead
+	// If no translation directory was found, only the hard-coded translations are used
 	if basePath == "" {
-		// Fallback to hard-coded translations
-		addHardCodedTranslations()
 		return
 	}
 
 	// Load translation files for all supported locales
+	// If a file is not found, the hard-coded translations for this locale remain in use
 	for _, locale := range supportedLocales {
 		filePath := filepath.Join(basePath, locale+".json")
-		if fileExists(filePath) {
-			// Load the translation file
-			bundle.MustLoadMessageFile(filePath)
-		} else {
-			// File not found, use embedded translations for this locale
-			addHardCodedTranslationsForLocale(locale)
+		if !fileExists(filePath) {
+			continue
+		}
+
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			panic(err)
+		}
+		messages, err := parseMessageFile(data, filePath)
+		if err != nil {
+			panic(err)
 		}
+		t.add(locale, messages...)
+	}
+}
+
+// loadEmbeddedTranslations adds the translations compiled in with -tags=embed_resources
+func loadEmbeddedTranslations(t *translations) {
+	for _, locale := range supportedLocales {
+		data, ok := embeddedTranslations[locale]
+		if !ok {
+			continue
+		}
+
+		messages, err := parseMessageFile([]byte(data), locale+".json")
+		if err != nil {
+			// Keep the translations loaded so far for this locale
+			continue
+		}
+		t.add(locale, messages...)
 	}
 }
 
@@ -88,290 +91,26 @@ func fileExists(path string) bool {
 	return !info.IsDir()
 }
 
-// addHardCodedTranslations adds built-in translations as a fallback
-func addHardCodedTranslations() {
-	// Add translations for all supported locales
+// addBuiltinTranslations adds the translations generated from i18n/locales
+func addBuiltinTranslations(t *translations) {
 	for _, locale := range supportedLocales {
-		addHardCodedTranslationsForLocale(locale)
-	}
-}
-
-// addHardCodedTranslationsForLocale adds built-in translations for a specific locale
-func addHardCodedTranslationsForLocale(locale string) {
-	switch locale {
-	case "en":
-		// English translations
-		addMonthTranslations("en", []string{
-			"January", "February", "March", "April", "May", "June",
-			"July", "August", "September", "October", "November", "December",
-		}, []string{
-			"Jan", "Feb", "Mar", "Apr", "May", "Jun",
-			"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
-		})
-
-		addWeekdayTranslations("en", []string{
-			"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
-		}, []string{
-			"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat",
-		})
-
-	case "fr":
-		// French translations
-		addMonthTranslations("fr", []string{
-			"Janvier", "Février", "Mars", "Avril", "Mai", "Juin",
-			"Juillet", "Août", "Septembre", "Octobre", "Novembre", "Décembre",
-		}, []string{
-			"Jan", "Fév", "Mar", "Avr", "Mai", "Jun",
-			"Jul", "Aoû", "Sep", "Oct", "Nov", "Déc",
-		})
-
-		addWeekdayTranslations("fr", []string{
-			"Dimanche", "Lundi", "Mardi", "Mercredi", "Jeudi", "Vendredi", "Samedi",
-		}, []string{
-			"Dim", "Lun", "Mar", "Mer", "Jeu", "Ven", "Sam",
-		})
-
-	case "es":
-		// Spanish translations
-		addMonthTranslations("es", []string{
-			"Enero", "Febrero", "Marzo", "Abril", "Mayo", "Junio",
-			"Julio", "Agosto", "Septiembre", "Octubre", "Noviembre", "Diciembre",
-		}, []string{
-			"Ene", "Feb", "Mar", "Abr", "May", "Jun",
-			"Jul", "Ago", "Sep", "Oct", "Nov", "Dic",
-		})
-
-		addWeekdayTranslations("es", []string{
-			"Domingo", "Lunes", "Martes", "Miércoles", "Jueves", "Viernes", "Sábado",
-		}, []string{
-			"Dom", "Lun", "Mar", "Mié", "Jue", "Vie", "Sáb",
-		})
-
-	case "de":
-		// German translations
-		addMonthTranslations("de", []string{
-			"Januar", "Februar", "März", "April", "Mai", "Juni",
-			"Juli", "August", "September", "Oktober", "November", "Dezember",
-		}, []string{
-			"Jan", "Feb", "Mär", "Apr", "Mai", "Jun",
-			"Jul", "Aug", "Sep", "Okt", "Nov", "Dez",
-		})
-
-		addWeekdayTranslations("de", []string{
-			"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag",
-		}, []string{
-			"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa",
-		})
-
-	case "ja":
-		// Japanese translations
-		addMonthTranslations("ja", []string{
-			"1月", "2月", "3月", "4月", "5月", "6月",
-			"7月", "8月", "9月", "10月", "11月", "12月",
-		}, []string{
-			"1月", "2月", "3月", "4月", "5月", "6月",
-			"7月", "8月", "9月", "10月", "11月", "12月",
-		})
-
-		addWeekdayTranslations("ja", []string{
-			"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日",
-		}, []string{
-			"日", "月", "火", "水", "木", "金", "土",
-		})
-
-	case "ko":
-		// Korean translations
-		addMonthTranslations("ko", []string{
-			"1월", "2월", "3월", "4월", "5월", "6월",
-			"7월", "8월", "9월", "10월", "11월", "12월",
-		}, []string{
-			"1월", "2월", "3월", "4월", "5월", "6월",
-			"7월", "8월", "9월", "10월", "11월", "12월",
-		})
-
-		addWeekdayTranslations("ko", []string{
-			"일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일",
-		}, []string{
-			"일", "월", "화", "수", "목", "금", "토",
-		})
-
-	case "zh-CN", "zh":
-		// Chinese Simplified translations
-		addMonthTranslations("zh-CN", []string{
-			"一月", "二月", "三月", "四月", "五月", "六月",
-			"七月", "八月", "九月", "十月", "十一月", "十二月",
-		}, []string{
-			"1月", "2月", "3月", "4月", "5月", "6月",
-			"7月", "8月", "9月", "10月", "11月", "12月",
-		})
-
-		addWeekdayTranslations("zh-CN", []string{
-			"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六",
-		}, []string{
-			"日", "一", "二", "三", "四", "五", "六",
-		})
-
-	case "zh-TW":
-		// Chinese Traditional translations
-		addMonthTranslations("zh-TW", []string{
-			"一月", "二月", "三月", "四月", "五月", "六月",
-			"七月", "八月", "九月", "十月", "十一月", "十二月",
-		}, []string{
-			"1月", "2月", "3月", "4月", "5月", "6月",
-			"7月", "8月", "9月", "10月", "11月", "12月",
-		})
-
-		addWeekdayTranslations("zh-TW", []string{
-			"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六",
-		}, []string{
-			"日", "一", "二", "三", "四", "五", "六",
-		})
-
-	case "vi":
-		// Vietnamese translations
-		addMonthTranslations("vi", []string{
-			"Tháng Một", "Tháng Hai", "Tháng Ba", "Tháng Tư", "Tháng Năm", "Tháng Sáu",
-			"Tháng Bảy", "Tháng Tám", "Tháng Chín", "Tháng Mười", "Tháng Mười Một", "Tháng Mười Hai",
-		}, []string{
-			"Th1", "Th2", "Th3", "Th4", "Th5", "Th6",
-			"Th7", "Th8", "Th9", "Th10", "Th11", "Th12",
-		})
-
-		addWeekdayTranslations("vi", []string{
-			"Chủ Nhật", "Thứ Hai", "Thứ Ba", "Thứ Tư", "Thứ Năm", "Thứ Sáu", "Thứ Bảy",
-		}, []string{
-			"CN", "T2", "T3", "T4", "T5", "T6", "T7",
-		})
-	}
-}
-
-// Helper function to add month translations to the bundle
-func addMonthTranslations(lang string, longNames, shortNames []string) {
-	for i, name := range longNames {
-		bundle.AddMessages(language.MustParse(lang), &i18n.Message{
-			ID:    fmt.Sprintf("month.long.%d", i+1),
-			Other: name,
-		})
-	}
-
-	for i, name := range shortNames {
-		bundle.AddMessages(language.MustParse(lang), &i18n.Message{
-			ID:    fmt.Sprintf("month.short.%d", i+1),
-			Other: name,
-		})
-	}
-}
-
-// Helper function to add weekday translations to the bundle
-func addWeekdayTranslations(lang string, longNames, shortNames []string) {
-	for i, name := range longNames {
-		bundle.AddMessages(language.MustParse(lang), &i18n.Message{
-			ID:    fmt.Sprintf("weekday.long.%d", i),
-			Other: name,
-		})
-	}
-
-	for i, name := range shortNames {
-		bundle.AddMessages(language.MustParse(lang), &i18n.Message{
-			ID:    fmt.Sprintf("weekday.short.%d", i),
-			Other: name,
-		})
-	}
-}
-
-// Get a localizer for the specified locale
-func getLocalizer(locale string) *i18n.Localizer {
-	// Convert locale format from "en_US" to "en-US" if needed
-	var tag language.Tag
-	localeParts := strings.Split(locale, "_")
-	if len(localeParts) == 2 {
-		tag = language.MustParse(localeParts[0] + "-" + localeParts[1])
-	} else if locale != "" {
-		tag = language.MustParse(locale)
-	} else {
-		tag = language.English
-	}
-
-	return i18n.NewLocalizer(bundle, tag.String())
-}
-
-// Get localized month name (short or long)
-func getMonthName(month time.Month, locale string, short bool) string {
-	localizer := getLocalizer(locale)
-
-	var format string
-	if short {
-		format = "month.short." + strconv.Itoa(int(month))
-	} else {
-		format = "month.long." + strconv.Itoa(int(month))
-	}
-
-	localized, err := localizer.Localize(&i18n.LocalizeConfig{
-		MessageID: format,
-	})
-
-	if err != nil {
-		// Fallback to English format if translation not found
-		if short {
-			return time.Month(month).String()[:3]
-		}
-		return time.Month(month).String()
-	}
-
-	return localized
-}
-
-// Get localized weekday name (short or long)
-func getWeekdayName(weekday time.Weekday, locale string, short bool) string {
-	localizer := getLocalizer(locale)
-
-	var format string
-	if short {
-		format = "weekday.short." + strconv.Itoa(int(weekday))
-	} else {
-		format = "weekday.long." + strconv.Itoa(int(weekday))
-	}
-
-	localized, err := localizer.Localize(&i18n.LocalizeConfig{
-		MessageID: format,
-	})
-
-	if err != nil {
-		// Fallback to English format if translation not found
-		if short {
-			return time.Weekday(weekday).String()[:3]
-		}
-		return time.Weekday(weekday).String()
+		t.add(locale, builtinTranslations[locale]...)
 	}
-
-	return localized
-}
-
-// Format utility functions
-func shortenAmPm(text string) string {
-	shortened := strings.ReplaceAll(strings.ReplaceAll(text, " AM", "am"), " PM", "pm")
-	if strings.Contains(shortened, "m") {
-		return strings.ReplaceAll(shortened, ":00", "")
-	}
-	return shortened
-}
-
-func removeLeadingZero(text string) string {
-	return strings.TrimPrefix(text, "0")
 }
 
 // Determine if the locale typically uses 24-hour time format
 // This is based on common international standards
 func is24Hour(locale string) bool {
 	// Extract language and country if available
-	var language, country string
-
-	parts := strings.Split(locale, "_")
-	if len(parts) >= 1 {
-		language = strings.ToLower(parts[0])
+	tag, err := parseLocale(locale)
+	if err != nil {
+		return false
 	}
-	if len(parts) >= 2 {
-		country = strings.ToUpper(parts[1])
+	base, _, region := tag.Raw()
+	language := base.String()
+	var country string
+	if region.String() != "ZZ" {
+		country = region.String()
 	}
 
 	// United States is known to use 12-hour format
@@ -396,7 +135,7 @@ func is24Hour(locale string) bool {
 		if country == "MX" || country == "CO" || country == "AR" || country == "CL" {
 			return false
 		}
-	case "zh", "zh-cn", "zh-tw":
+	case "zh":
 		// Chinese typically uses 12-hour in informal contexts
 		return false
 	case "ja":
@@ -420,39 +159,52 @@ func is24Hour(locale string) bool {
 	return true
 }
 
+// FormatTime formats the time of day of date the way it is shown in a date range,
+// e.g. "2:30pm" or "14:30" depending on the locale.
 func FormatTime(date time.Time, locale string) string {
-	if is24Hour(locale) {
-		hour := date.Hour()
-		minute := date.Minute()
-		formatted := fmt.Sprintf("%02d:%02d", hour, minute)
-
-		// Remove leading zero from hours for 24-hour format
-		if hour < 10 {
-			return formatted[1:]
-		}
-		return formatted
-	} else {
-		hour := date.Hour()
-		minute := date.Minute()
-		period := "AM"
-		if hour >= 12 {
-			period = "PM"
-			if hour > 12 {
-				hour -= 12
-			}
-		}
-		if hour == 0 {
-			hour = 12
+	var buf [64]byte
+	data := lookupLocale(locale)
+	return string(appendTime(buf[:0], date, data.hour24, data.digits))
+}
+
+// appendTime appends the formatted time of day to b, with numbers in a numbering system
+func appendTime(b []byte, date time.Time, hour24 bool, system NumberingSystem) []byte {
+	hour := date.Hour()
+	minute := date.Minute()
+
+	if hour24 {
+		// No leading zero on the hour for 24-hour format, e.g. "9:05", "14:00"
+		b = appendNumber(b, hour, system)
+		b = append(b, ':')
+		return appendTwoDigitNumber(b, minute, system)
+	}
+
+	period := "am"
+	if hour >= 12 {
+		period = "pm"
+		if hour > 12 {
+			hour -= 12
 		}
-		formatted := fmt.Sprintf("%d:%02d %s", hour, minute, period)
-		return removeLeadingZero(shortenAmPm(formatted))
 	}
+	if hour == 0 {
+		hour = 12
+	}
+
+	// Full hours are shortened, e.g. "12pm" instead of "12:00pm"
+	b = appendNumber(b, hour, system)
+	if minute != 0 {
+		b = append(b, ':')
+		b = appendTwoDigitNumber(b, minute, system)
+	}
+	return append(b, period...)
 }
 
-func createFormatTime(locale string) func(time.Time) string {
-	return func(date time.Time) string {
-		return FormatTime(date, locale)
+// appendTwoDigits appends n zero-padded to two digits, like "%02d"
+func appendTwoDigits(b []byte, n int) []byte {
+	if n >= 0 && n < 10 {
+		b = append(b, '0')
 	}
+	return strconv.AppendInt(b, int64(n), 10)
 }
 
 // DateRangeFormatOptions specifies configuration options for formatting a date range.
@@ -462,7 +214,9 @@ type DateRangeFormatOptions struct {
 	Today time.Time
 
 	// Locale determines the language and regional formatting to use.
-	// Supported values include "en_US", "en_GB", "fr", "es", "de", etc.
+	// Both BCP 47 tags ("en-GB", "zh-Hant-HK") and POSIX-style identifiers
+	// ("en_US", "zh_TW") are accepted. Locales without their own translations
+	// fall back to the closest match; use MatchLocale to see which one is used.
 	// If not specified, "en_US" will be used.
 	Locale string
 
@@ -473,13 +227,45 @@ type DateRangeFormatOptions struct {
 	// Separator is the string used to separate date ranges.
 	// If not specified, "-" will be used.
 	Separator string
+
+	// Location is the time zone the dates are shown in.
+	// If not specified, each date is shown in its own time zone.
+	Location *time.Location
+
+	// Calendar is the calendar system dates are shown in, e.g. JapaneseEra.
+	// If not specified, the Gregorian calendar will be used.
+	Calendar Calendar
+
+	// Annotation is a second calendar the days of the range are also shown
+	// in, in parentheses after the range, e.g. Vietnamese for
+	// "Jan 22 - 26 (Mùng 1 - 5 Tết)". Dates it does not cover are not annotated.
+	// Default is no annotation.
+	Annotation Calendar
+
+	// NumberingSystem is the set of digits numbers are written with, e.g.
+	// ArabicDigits or ChineseNumerals. If not specified, the digits of the
+	// locale are used: Arabic digits for most Arabic locales such as "ar-EG",
+	// Persian digits for "fa" and Latin digits for most others. A locale can
+	// also choose them with a "-u-nu-" extension, e.g. "th-TH-u-nu-thai".
+	NumberingSystem NumberingSystem
+
+	// Bidi protects the numbers of the output from being reordered when it
+	// is shown next to text of the other direction, with BidiIsolate or
+	// BidiMarks. Right-to-left locales such as "ar" and "he" are laid out
+	// day first either way. Default is BidiNone.
+	Bidi BidiMode
+
+	// BridgeWeekends makes FormatDates treat days that are only separated by
+	// a weekend as consecutive, e.g. Friday and the following Monday.
+	// Default is false.
+	BridgeWeekends bool
 }
 
 // Helper time functions
 func isSameMinute(t1, t2 time.Time) bool {
-	return t1.Year() == t2.Year() &&
-		t1.Month() == t2.Month() &&
-		t1.Day() == t2.Day() &&
+	y1, m1, d1 := t1.Date()
+	y2, m2, d2 := t2.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2 &&
 		t1.Hour() == t2.Hour() &&
 		t1.Minute() == t2.Minute()
 }
@@ -534,151 +320,278 @@ func getQuarter(t time.Time) int {
 // - Q1 2023
 // - Jan 1 '22 - Jan 20 '23
 func FormatDateRange(from, to time.Time, options DateRangeFormatOptions) string {
-	// Set default values if not provided
+	var buf [64]byte
+	return string(appendDateRange(buf[:0], from, to, options))
+}
+
+// AppendDateRange is like FormatDateRange but appends the formatted range to dst
+// and returns the extended buffer, mirroring time.Time.AppendFormat.
+// Reusing dst across calls avoids allocating a string per range.
+func AppendDateRange(dst []byte, from, to time.Time, options DateRangeFormatOptions) []byte {
+	return appendDateRange(dst, from, to, options)
+}
+
+// Buffers reused by WriteDateRange
+var writeBufferPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 0, 64)
+		return &buf
+	},
+}
+
+// WriteDateRange is like FormatDateRange but writes the formatted range to w.
+// It returns the number of bytes written and any error returned by w.
+func WriteDateRange(w io.Writer, from, to time.Time, options DateRangeFormatOptions) (int, error) {
+	buf := writeBufferPool.Get().(*[]byte)
+	defer writeBufferPool.Put(buf)
+
+	*buf = appendDateRange((*buf)[:0], from, to, options)
+	return w.Write(*buf)
+}
+
+// setDefaults sets default values for the options that were not provided
+func setDefaults(options *DateRangeFormatOptions) {
 	if options.Today.IsZero() {
 		options.Today = time.Now()
 	}
 	if options.Locale == "" {
-		options.Locale = "en_US"
+		options.Locale = defaultLocale
 	}
 	if options.Separator == "" {
 		options.Separator = "-"
 	}
-
-	// Extract language code from locale if needed (e.g. "en_US" -> "en")
-	lang := options.Locale
-	if parts := strings.Split(options.Locale, "_"); len(parts) > 0 {
-		lang = parts[0]
+	if options.Location != nil {
+		options.Today = options.Today.In(options.Location)
 	}
+}
 
-	sameYear := from.Year() == to.Year()
-	sameMonth := from.Month() == to.Month() && sameYear
-	sameDay := from.Day() == to.Day() && sameMonth
-	thisYear := from.Year() == options.Today.Year()
-	thisDay := from.Day() == options.Today.Day() &&
-		from.Month() == options.Today.Month() &&
-		from.Year() == options.Today.Year()
+// appendMonthDay appends the short month name and the day in a calendar, e.g. "Jan 1"
+func appendMonthDay(b []byte, cal Calendar, locale *localeData, t time.Time) []byte {
+	if c, ok := cal.(lunisolar); ok && c.covers(t) {
+		return c.appendDays(b, locale, t, t, "")
+	}
+	year, month, day := cal.Date(t)
+	if locale.rtl {
+		// Right-to-left locales put the day first, e.g. "١ يناير"
+		b = appendNumber(b, day, locale.digits)
+		b = append(b, ' ')
+		return append(b, cal.monthName(locale, year, month, false)...)
+	}
+	b = append(b, cal.monthName(locale, year, month, false)...)
+	b = append(b, ' ')
+	return appendNumber(b, day, locale.digits)
+}
 
-	var yearSuffix string
-	if !thisYear {
-		yearSuffix = fmt.Sprintf(", %d", from.Year())
+// appendDays appends a range of days within one month of a calendar, e.g. "Jan 1 - 12"
+func appendDays(b []byte, cal Calendar, locale *localeData, from, to time.Time, separator string) []byte {
+	if c, ok := cal.(lunisolar); ok && c.covers(from) {
+		return c.appendDays(b, locale, from, to, separator)
+	}
+	if locale.rtl {
+		// Example: ١ - ١٢ يناير
+		_, _, day := cal.Date(from)
+		b = appendNumber(b, day, locale.digits)
+		b = append(b, ' ')
+		b = append(b, separator...)
+		b = append(b, ' ')
+		return appendMonthDay(b, cal, locale, to)
+	}
+	b = appendMonthDay(b, cal, locale, from)
+	b = append(b, ' ')
+	b = append(b, separator...)
+	b = append(b, ' ')
+	_, _, day := cal.Date(to)
+	return appendNumber(b, day, locale.digits)
+}
+
+// appendYearSuffix appends a year unless it is the current one, e.g. ", 2022"
+func appendYearSuffix(b []byte, locale *localeData, year int, thisYear bool) []byte {
+	if thisYear {
+		return b
 	}
+	b = append(b, locale.names.comma...)
+	return appendNumber(b, year, locale.digits)
+}
 
-	formatTimeFunc := createFormatTime(options.Locale)
+// appendDateRange renders the date range into b.
+// It is the shared implementation behind FormatDateRange.
+func appendDateRange(b []byte, from, to time.Time, options DateRangeFormatOptions) []byte {
+	start := len(b)
+	b = appendRange(b, from, to, options, false)
+	if options.Annotation != nil {
+		b = appendAnnotation(b, from, to, options)
+	}
+	return protectNumbers(b, start, options)
+}
 
-	var startTimeSuffix, endTimeSuffix string
+// appendAnnotation appends the days of the range in the annotation calendar,
+// e.g. " (Mùng 1 - 5 Tết)". Years and times are only shown in the range itself.
+func appendAnnotation(b []byte, from, to time.Time, options DateRangeFormatOptions) []byte {
+	setDefaults(&options)
+	if options.Location != nil {
+		from = from.In(options.Location)
+		to = to.In(options.Location)
+	}
+	cal := options.Annotation
+	if c, ok := cal.(lunisolar); ok && !(c.covers(from) && c.covers(to)) {
+		return b
+	}
+	locale := localeOf(options)
+
+	fromYear, fromMonth, _ := cal.Date(from)
+	toYear, toMonth, _ := cal.Date(to)
+	b = append(b, " ("...)
+	switch {
+	case sameDay(from, to):
+		b = appendMonthDay(b, cal, locale, from)
+	case fromYear == toYear && fromMonth == toMonth:
+		b = appendDays(b, cal, locale, from, to, options.Separator)
+	default:
+		b = appendMonthDay(b, cal, locale, from)
+		b = append(b, ' ')
+		b = append(b, options.Separator...)
+		b = append(b, ' ')
+		b = appendMonthDay(b, cal, locale, to)
+	}
+	return append(b, ')')
+}
+
+// appendRange renders the date range into b. If omitYear is set, the year
+// suffix is left out because the caller prints it once for several ranges.
+func appendRange(b []byte, from, to time.Time, options DateRangeFormatOptions, omitYear bool) []byte {
+	setDefaults(&options)
+	if options.Location != nil {
+		from = from.In(options.Location)
+		to = to.In(options.Location)
+	}
+
+	// Month and weekday names come from precomputed tables. Regional variants
+	// such as "zh_TW" or "zh-Hant-HK" are resolved through MatchLocale.
+	locale := localeOf(options)
+
+	// Years, months and days are those of the calendar, which may also write
+	// the whole range in its own layout. Such calendars are called by their
+	// concrete type so that b does not escape to the heap.
+	cal := calendarOf(options)
+	if j, ok := cal.(japanese); ok {
+		return j.appendRange(b, from, to, options, locale)
+	}
+	fromYear, fromMonth, fromDay := cal.Date(from)
+	toYear, toMonth, toDay := cal.Date(to)
+	todayYear, todayMonth, todayDay := cal.Date(options.Today)
+
+	sameYear := fromYear == toYear
+	sameMonth := fromMonth == toMonth && sameYear
+	sameDay := fromDay == toDay && sameMonth
+	thisYear := omitYear || fromYear == todayYear
+	thisDay := fromDay == todayDay && fromMonth == todayMonth && fromYear == todayYear
+
+	var startTime, endTime bool
 	if options.IncludeTime {
-		if !isSameMinute(startOfDay(from), from) {
-			startTimeSuffix = ", " + formatTimeFunc(from)
-		}
-		if !isSameMinute(endOfDay(to), to) {
-			endTimeSuffix = ", " + formatTimeFunc(to)
+		startTime = !isSameMinute(startOfDay(from), from)
+		endTime = !isSameMinute(endOfDay(to), to)
+	}
+
+	// appendTimeSuffix adds the time if it should be shown, e.g. ", 2:30pm"
+	appendTimeSuffix := func(b []byte, t time.Time, show bool) []byte {
+		if !show {
+			return b
 		}
+		b = append(b, locale.names.comma...)
+		return appendTime(b, t, locale.hour24, locale.digits)
+	}
+
+	// appendSeparator adds the separator surrounded by spaces, e.g. " - "
+	appendSeparator := func(b []byte) []byte {
+		b = append(b, ' ')
+		b = append(b, options.Separator...)
+		return append(b, ' ')
 	}
 
 	// Check if the range is the entire year
-	if isSameMinute(startOfYear(from), from) && isSameMinute(endOfYear(to), to) {
-		return fmt.Sprintf("%d", from.Year())
+	if isSameMinute(startOfCalendarYear(cal, from), from) && isSameMinute(endOfCalendarYear(cal, to), to) {
+		return appendNumber(b, fromYear, locale.digits)
 	}
 
 	// Check if the range is an entire quarter
-	if isSameMinute(startOfQuarter(from), from) &&
-		isSameMinute(endOfQuarter(to), to) &&
-		getQuarter(from) == getQuarter(to) {
-		return fmt.Sprintf("Q%d %d", getQuarter(from), from.Year())
+	if isCalendarQuarter(cal, from, to) {
+		quarter, _ := calendarQuarter(cal, from)
+		b = append(b, 'Q')
+		b = strconv.AppendInt(b, int64(quarter), 10)
+		b = append(b, ' ')
+		return appendNumber(b, fromYear, locale.digits)
 	}
 
 	// Check if the range is across entire month
-	if isSameMinute(startOfMonth(from), from) && isSameMinute(endOfMonth(to), to) {
+	if isSameMinute(startOfCalendarMonth(cal, from), from) && isSameMinute(endOfCalendarMonth(cal, to), to) {
 		if sameMonth && sameYear {
 			// Example: January 2023
-			return getMonthName(from.Month(), lang, false) + " " + strconv.Itoa(from.Year())
+			b = append(b, cal.monthName(locale, fromYear, fromMonth, true)...)
+			b = append(b, ' ')
+			return appendNumber(b, fromYear, locale.digits)
 		}
 		// Example: Jan - Feb 2023
-		return fmt.Sprintf("%s %s %s %d",
-			getMonthName(from.Month(), lang, true),
-			options.Separator,
-			getMonthName(to.Month(), lang, true),
-			to.Year())
+		b = append(b, cal.monthName(locale, fromYear, fromMonth, false)...)
+		b = appendSeparator(b)
+		b = append(b, cal.monthName(locale, toYear, toMonth, false)...)
+		b = append(b, ' ')
+		return appendNumber(b, toYear, locale.digits)
 	}
 
 	// Range across years
+	// Example: Jan 1 '22 - Jan 20 '23
 	if !sameYear {
-		fromYear := " '" + fmt.Sprintf("%02d", from.Year()%100)
-		toYear := " '" + fmt.Sprintf("%02d", to.Year()%100)
-
-		return fmt.Sprintf("%s %d%s %s %s %d%s",
-			getMonthName(from.Month(), lang, true),
-			from.Day(),
-			fromYear+startTimeSuffix,
-			options.Separator,
-			getMonthName(to.Month(), lang, true),
-			to.Day(),
-			toYear+endTimeSuffix)
-	}
-
-	// Range across months
-	if !sameMonth {
-		return fmt.Sprintf("%s %d%s %s %s %d%s%s",
-			getMonthName(from.Month(), lang, true),
-			from.Day(),
-			startTimeSuffix,
-			options.Separator,
-			getMonthName(to.Month(), lang, true),
-			to.Day(),
-			endTimeSuffix,
-			yearSuffix)
+		b = appendMonthDay(b, cal, locale, from)
+		b = append(b, " '"...)
+		b = appendTwoDigitNumber(b, fromYear%100, locale.digits)
+		b = appendTimeSuffix(b, from, startTime)
+		b = appendSeparator(b)
+		b = appendMonthDay(b, cal, locale, to)
+		b = append(b, " '"...)
+		b = appendTwoDigitNumber(b, toYear%100, locale.digits)
+		return appendTimeSuffix(b, to, endTime)
+	}
+
+	// Range across months, or across days with a time suffix, in which case
+	// the month is printed twice
+	// Example: Jan 1, 12:11am - Jan 2, 2:30pm[, 2023]
+	if !sameMonth || (!sameDay && (startTime || endTime)) {
+		b = appendMonthDay(b, cal, locale, from)
+		b = appendTimeSuffix(b, from, startTime)
+		b = appendSeparator(b)
+		b = appendMonthDay(b, cal, locale, to)
+		b = appendTimeSuffix(b, to, endTime)
+		return appendYearSuffix(b, locale, fromYear, thisYear)
 	}
 
 	// Range across days
+	// Example: Jan 1 - 12[, 2023]
 	if !sameDay {
-		// Check for a time suffix, if so print the month twice
-		if startTimeSuffix != "" || endTimeSuffix != "" {
-			return fmt.Sprintf("%s %d%s %s %s %d%s%s",
-				getMonthName(from.Month(), lang, true),
-				from.Day(),
-				startTimeSuffix,
-				options.Separator,
-				getMonthName(to.Month(), lang, true),
-				to.Day(),
-				endTimeSuffix,
-				yearSuffix)
-		}
-
-		// Example: Jan 1 - 12[, 2023]
-		return fmt.Sprintf("%s %d %s %d%s",
-			getMonthName(from.Month(), lang, true),
-			from.Day(),
-			options.Separator,
-			to.Day(),
-			yearSuffix)
+		b = appendDays(b, cal, locale, from, to, options.Separator)
+		return appendYearSuffix(b, locale, fromYear, thisYear)
 	}
 
 	// Same day, different times
-	if startTimeSuffix != "" || endTimeSuffix != "" {
+	if startTime || endTime {
 		// If it's today, don't include the date
 		if thisDay {
-			return fmt.Sprintf("%s %s %s",
-				formatTimeFunc(from),
-				options.Separator,
-				formatTimeFunc(to))
+			b = appendTime(b, from, locale.hour24, locale.digits)
+			b = appendSeparator(b)
+			return appendTime(b, to, locale.hour24, locale.digits)
 		}
 
 		// Example: Jan 1, 12pm - 1pm[, 2023]
-		return fmt.Sprintf("%s %d%s %s %s%s",
-			getMonthName(from.Month(), lang, true),
-			from.Day(),
-			startTimeSuffix,
-			options.Separator,
-			formatTimeFunc(to),
-			yearSuffix)
+		b = appendMonthDay(b, cal, locale, from)
+		b = appendTimeSuffix(b, from, startTime)
+		b = appendSeparator(b)
+		b = appendTime(b, to, locale.hour24, locale.digits)
+		return appendYearSuffix(b, locale, fromYear, thisYear)
 	}
 
 	// Full day
 	// Example: Fri, Jan 1[, 2023]
-	return fmt.Sprintf("%s, %s %d%s",
-		getWeekdayName(from.Weekday(), lang, true),
-		getMonthName(from.Month(), lang, true),
-		from.Day(),
-		yearSuffix)
+	b = append(b, locale.names.shortWeekdays[from.Weekday()]...)
+	b = append(b, locale.names.comma...)
+	b = appendMonthDay(b, cal, locale, from)
+	return appendYearSuffix(b, locale, fromYear, thisYear)
 }
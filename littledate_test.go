@@ -1,6 +1,8 @@
 package littledate
 
 import (
+	"bytes"
+	"io"
 	"testing"
 	"time"
 )
@@ -29,6 +31,16 @@ func TestIs24Hour(t *testing.T) {
 			locale:   "en_GB",
 			expected: true,
 		},
+		{
+			name:     "en-GB BCP 47 locale should be 24-hour",
+			locale:   "en-GB",
+			expected: true,
+		},
+		{
+			name:     "zh-TW locale should be 12-hour",
+			locale:   "zh-TW",
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -200,3 +212,145 @@ func TestFormatDateRange(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatDateRangeAllocations(t *testing.T) {
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+	}{
+		{
+			name: "same month",
+			from: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2023, 1, 12, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			name: "different years with time",
+			from: time.Date(2022, 1, 1, 0, 11, 0, 0, time.UTC),
+			to:   time.Date(2023, 1, 2, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "full month",
+			from: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2023, 4, 30, 23, 59, 59, 999999999, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The only allocation allowed is the returned string
+			allocs := testing.AllocsPerRun(100, func() {
+				FormatDateRange(tt.from, tt.to, defaultOptions)
+			})
+			if allocs > 1 {
+				t.Errorf("FormatDateRange() allocates %v times, want at most 1", allocs)
+			}
+		})
+	}
+}
+
+func TestAppendDateRange(t *testing.T) {
+	ranges := []struct {
+		from time.Time
+		to   time.Time
+	}{
+		{time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 12, 23, 59, 59, 999999999, time.UTC)},
+		{time.Date(2022, 1, 1, 0, 11, 0, 0, time.UTC), time.Date(2023, 1, 2, 14, 30, 0, 0, time.UTC)},
+		{time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 3, 31, 23, 59, 59, 999999999, time.UTC)},
+		{today, today.Add(time.Hour)},
+	}
+
+	prefix := []byte("range: ")
+	for _, r := range ranges {
+		expected := FormatDateRange(r.from, r.to, defaultOptions)
+
+		appended := AppendDateRange(append([]byte(nil), prefix...), r.from, r.to, defaultOptions)
+		if string(appended) != string(prefix)+expected {
+			t.Errorf("AppendDateRange() = %q, want %q", appended, string(prefix)+expected)
+		}
+
+		var buf bytes.Buffer
+		n, err := WriteDateRange(&buf, r.from, r.to, defaultOptions)
+		if err != nil || n != len(expected) || buf.String() != expected {
+			t.Errorf("WriteDateRange() = %q, %d, %v, want %q", buf.String(), n, err, expected)
+		}
+	}
+}
+
+func TestAppendDateRangeAllocations(t *testing.T) {
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 1, 12, 23, 59, 59, 999999999, time.UTC)
+
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = AppendDateRange(buf[:0], from, to, defaultOptions)
+	})
+	if allocs != 0 {
+		t.Errorf("AppendDateRange() allocates %v times, want 0", allocs)
+	}
+
+	allocs = testing.AllocsPerRun(100, func() {
+		WriteDateRange(io.Discard, from, to, defaultOptions)
+	})
+	if allocs != 0 {
+		t.Errorf("WriteDateRange() allocates %v times, want 0", allocs)
+	}
+}
+
+func BenchmarkFormatDateRange(b *testing.B) {
+	benchmarks := []struct {
+		name    string
+		from    time.Time
+		to      time.Time
+		options DateRangeFormatOptions
+	}{
+		{
+			name:    "same month",
+			from:    time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:      time.Date(2023, 1, 12, 23, 59, 59, 999999999, time.UTC),
+			options: defaultOptions,
+		},
+		{
+			name:    "different years with time",
+			from:    time.Date(2022, 1, 1, 0, 11, 0, 0, time.UTC),
+			to:      time.Date(2023, 1, 2, 14, 30, 0, 0, time.UTC),
+			options: defaultOptions,
+		},
+		{
+			name:    "localized",
+			from:    time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC),
+			to:      time.Date(2023, 4, 20, 23, 59, 59, 999999999, time.UTC),
+			options: DateRangeFormatOptions{Today: today, Locale: "zh-Hant-HK"},
+		},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				FormatDateRange(bm.from, bm.to, bm.options)
+			}
+		})
+	}
+}
+
+func BenchmarkAppendDateRange(b *testing.B) {
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 1, 12, 23, 59, 59, 999999999, time.UTC)
+
+	b.ReportAllocs()
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = AppendDateRange(buf[:0], from, to, defaultOptions)
+	}
+}
+
+func BenchmarkWriteDateRange(b *testing.B) {
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 1, 12, 23, 59, 59, 999999999, time.UTC)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		WriteDateRange(io.Discard, from, to, defaultOptions)
+	}
+}
@@ -0,0 +1,149 @@
+package littledate
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// defaultLocale is used when no locale is given in the options
+const defaultLocale = "en_US"
+
+// parentLocales lists explicit fallback steps that differ from simply
+// dropping the script or region subtag. They follow the CLDR parent locale
+// data: Commonwealth English falls back to British English, and Traditional
+// Chinese regions fall back to Taiwan rather than Simplified Chinese.
+var parentLocales = map[string]string{
+	"en-AU":   "en-GB",
+	"en-NZ":   "en-GB",
+	"en-IE":   "en-GB",
+	"en-IN":   "en-GB",
+	"en-ZA":   "en-GB",
+	"en-SG":   "en-GB",
+	"en-HK":   "en-GB",
+	"en-001":  "en-GB",
+	"en-150":  "en-GB",
+	"en-GB":   "en",
+	"zh":      "zh-CN",
+	"zh-Hans": "zh-CN",
+	"zh-SG":   "zh-CN",
+	"zh-MY":   "zh-CN",
+	"zh-Hant": "zh-TW",
+	"zh-HK":   "zh-TW",
+	"zh-MO":   "zh-TW",
+}
+
+// LocaleMatch describes how a requested locale was resolved against the
+// available translations.
+type LocaleMatch struct {
+	// Requested is the locale string as it was passed in.
+	Requested string
+
+	// Tag is the parsed BCP 47 form of the requested locale.
+	// It is language.Und if the locale could not be parsed.
+	Tag language.Tag
+
+	// Locale is the translation locale that is used for formatting, e.g. "zh-TW".
+	Locale string
+
+	// Fallbacks lists the locales that were tried, in order, before Locale was chosen.
+	Fallbacks []string
+
+	// Confidence reports how good the match is. language.No means that none
+	// of the translations fit and the default (English) is used.
+	Confidence language.Confidence
+}
+
+// MatchLocale reports which translation locale is used for the given
+// locale string. Both BCP 47 ("zh-Hant-HK") and POSIX-style ("zh_TW")
+// identifiers are accepted.
+func MatchLocale(locale string) LocaleMatch {
+	return currentSnapshot().match(locale)
+}
+
+// match resolves a locale string against the translations of the snapshot
+func (s *snapshot) match(locale string) LocaleMatch {
+	match := LocaleMatch{Requested: locale}
+
+	tag, err := parseLocale(locale)
+	if err != nil {
+		match.Tag = language.Und
+		match.Locale = s.locales[0]
+		match.Confidence = language.No
+		return match
+	}
+	match.Tag = tag
+
+	// Walk the explicit fallback chain first
+	for _, candidate := range fallbackChain(tag) {
+		if s.isSupported(candidate) {
+			match.Locale = candidate
+			match.Confidence = language.High
+			if len(match.Fallbacks) == 0 {
+				match.Confidence = language.Exact
+			}
+			return match
+		}
+		match.Fallbacks = append(match.Fallbacks, candidate)
+	}
+
+	// Let the language matcher pick the closest translation
+	_, index, confidence := s.matcher.Match(tag)
+	match.Locale = s.locales[index]
+	match.Confidence = confidence
+	return match
+}
+
+// parseLocale parses a BCP 47 or POSIX-style locale identifier
+func parseLocale(locale string) (language.Tag, error) {
+	if locale == "" {
+		locale = defaultLocale
+	}
+
+	// Convert locale format from "en_US" to "en-US" and drop any POSIX
+	// encoding or modifier suffix ("de_DE.UTF-8@euro")
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	locale = strings.ReplaceAll(locale, "_", "-")
+
+	return language.Parse(locale)
+}
+
+// fallbackChain lists the locales to try for a tag, from most to least specific
+func fallbackChain(tag language.Tag) []string {
+	base, script, region := tag.Raw()
+
+	var candidates []string
+	seen := make(map[string]bool)
+	add := func(candidate string) {
+		for candidate != "" && !seen[candidate] {
+			seen[candidate] = true
+			candidates = append(candidates, candidate)
+			candidate = parentLocales[candidate]
+		}
+	}
+
+	lang := base.String()
+	if script.String() != "Zzzz" && region.String() != "ZZ" {
+		add(lang + "-" + script.String() + "-" + region.String())
+	}
+	if script.String() != "Zzzz" {
+		add(lang + "-" + script.String())
+	}
+	if region.String() != "ZZ" {
+		add(lang + "-" + region.String())
+	}
+	add(lang)
+
+	return candidates
+}
+
+// newLocaleMatcher builds a language matcher over the given locales
+func newLocaleMatcher(locales []string) language.Matcher {
+	tags := make([]language.Tag, len(locales))
+	for i, locale := range locales {
+		tags[i] = language.MustParse(locale)
+	}
+	return language.NewMatcher(tags)
+}
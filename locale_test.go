@@ -0,0 +1,121 @@
+package littledate
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+func TestMatchLocale(t *testing.T) {
+	tests := []struct {
+		name       string
+		locale     string
+		expected   string
+		confidence language.Confidence
+	}{
+		{
+			name:       "exact match",
+			locale:     "fr",
+			expected:   "fr",
+			confidence: language.Exact,
+		},
+		{
+			name:       "POSIX identifier keeps its region",
+			locale:     "zh_TW",
+			expected:   "zh-TW",
+			confidence: language.Exact,
+		},
+		{
+			name:       "Traditional Chinese script falls back to zh-TW",
+			locale:     "zh-Hant-HK",
+			expected:   "zh-TW",
+			confidence: language.High,
+		},
+		{
+			name:       "Hong Kong Chinese falls back to zh-TW",
+			locale:     "zh_HK",
+			expected:   "zh-TW",
+			confidence: language.High,
+		},
+		{
+			name:       "bare Chinese falls back to zh-CN",
+			locale:     "zh",
+			expected:   "zh-CN",
+			confidence: language.High,
+		},
+		{
+			name:       "regional variant falls back to its language",
+			locale:     "fr-CA",
+			expected:   "fr",
+			confidence: language.High,
+		},
+		{
+			name:       "POSIX encoding suffix is ignored",
+			locale:     "de_DE.UTF-8",
+			expected:   "de",
+			confidence: language.High,
+		},
+		{
+			name:       "unsupported language reports no match",
+			locale:     "pt-BR",
+			expected:   "en",
+			confidence: language.No,
+		},
+		{
+			name:       "invalid locale reports no match",
+			locale:     "not a locale",
+			expected:   "en",
+			confidence: language.No,
+		},
+		{
+			name:       "empty locale uses the default",
+			locale:     "",
+			expected:   "en",
+			confidence: language.High,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := MatchLocale(tt.locale)
+			if match.Locale != tt.expected || match.Confidence != tt.confidence {
+				t.Errorf("MatchLocale(%q) = %s (%v), want %s (%v)",
+					tt.locale, match.Locale, match.Confidence, tt.expected, tt.confidence)
+			}
+		})
+	}
+}
+
+func TestMatchLocaleFallbacks(t *testing.T) {
+	match := MatchLocale("en-AU")
+	expected := []string{"en-AU", "en-GB"}
+	if match.Locale != "en" || !reflect.DeepEqual(match.Fallbacks, expected) {
+		t.Errorf("MatchLocale(en-AU) = %s via %v, want en via %v", match.Locale, match.Fallbacks, expected)
+	}
+}
+
+func TestFormatDateRangeLocaleMatching(t *testing.T) {
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 1, 31, 23, 59, 59, 999999999, time.UTC)
+
+	tests := []struct {
+		locale   string
+		expected string
+	}{
+		{locale: "ko-KR", expected: "1월 2023"},
+		{locale: "de_AT", expected: "Januar 2023"},
+		{locale: "zh-Hant-HK", expected: "一月 2023"},
+		{locale: "pt-BR", expected: "January 2023"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			options := DateRangeFormatOptions{Today: today, Locale: tt.locale}
+			if result := FormatDateRange(from, to, options); result != tt.expected {
+				t.Errorf("FormatDateRange() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,3149 @@
+// Code generated by go run ./internal/genlocales; DO NOT EDIT.
+
+package littledate
+
+import "github.com/nicksnyder/go-i18n/v2/i18n"
+
+// Supported languages with their built-in translations, one per file in i18n/locales.
+// The first entry is the default used when no other locale matches.
+var supportedLocales = []string{"en", "ar", "de", "es", "fa", "fr", "he", "ja", "ko", "th", "ur", "vi", "zh-CN", "zh-TW"}
+
+// Built-in translations, used when no external translation files are found
+var builtinTranslations = map[string][]*i18n.Message{
+	"en": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "{{.Time}} AM"},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{.Month}} {{.Day}}{{if .Year}}, {{.Year}}{{end}}"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "{{.Time}} PM"},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "Quarter {{.Quarter}}, {{.Year}}"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "{{.From}} to {{.To}}"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Weekday}}, {{.Date}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Month}} {{.Day}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "1"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "2"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "3"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "4"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "5"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "6"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "7"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "8"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "9"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "10"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "11"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "12"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "13"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "14"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "15"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "16"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "17"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "18"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "19"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "20"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "21"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "22"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "23"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "24"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "25"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "26"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "27"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "28"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "29"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "30"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Day}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "Leap {{.Month}}"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "First Month"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "Second Month"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "Third Month"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "Fourth Month"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "Fifth Month"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "Sixth Month"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "Seventh Month"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "Eighth Month"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "Ninth Month"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "Tenth Month"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "Eleventh Month"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "Twelfth Month"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "Mo1"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "Mo2"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "Mo3"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "Mo4"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "Mo5"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "Mo6"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "Mo7"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "Mo8"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "Mo9"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "Mo10"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "Mo11"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "Mo12"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "Tishrei"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "Heshvan"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "Kislev"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "Tevet"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "Shevat"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "Adar I"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "Adar"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "Adar II"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "Nisan"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "Iyar"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "Sivan"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "Tamuz"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "Av"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "Elul"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "Tishrei"},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "Heshvan"},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "Kislev"},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "Tevet"},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "Shevat"},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "Adar I"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "Adar"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "Adar II"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "Nisan"},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "Iyar"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "Sivan"},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "Tamuz"},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "Av"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "Elul"},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "Muharram"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "Safar"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "Rabiʻ I"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "Rabiʻ II"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "Jumada I"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "Jumada II"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "Rajab"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "Shaʻban"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "Ramadan"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "Shawwal"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "Dhuʻl-Qiʻdah"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "Dhuʻl-Hijjah"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "Muh."},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "Saf."},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "Rab. I"},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "Rab. II"},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "Jum. I"},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "Jum. II"},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "Raj."},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "Sha."},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "Ram."},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "Shaw."},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "Dhuʻl-Q."},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "Dhuʻl-H."},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "Farvardin"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "Ordibehesht"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "Khordad"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "Tir"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "Mordad"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "Shahrivar"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "Mehr"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "Aban"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "Azar"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "Dey"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "Bahman"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "Esfand"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "Farvardin"},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "Ordibehesht"},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "Khordad"},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "Tir"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "Mordad"},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "Shahrivar"},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "Mehr"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "Aban"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "Azar"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "Dey"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "Bahman"},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "Esfand"},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: ", "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", One: "{{.Count}} day", Other: "{{.Count}} days"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", One: "{{.Count}} hour", Other: "{{.Count}} hours"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", One: "{{.Count}} minute", Other: "{{.Count}} minutes"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}} {{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", One: "{{.Count}} second", Other: "{{.Count}} seconds"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}} and {{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}, {{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "January"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "February"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "March"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "April"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "May"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "June"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "July"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "August"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "September"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "October"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "November"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "December"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "Jan"},
+		{ID: "month.short.2", Description: "Short name of February", Other: "Feb"},
+		{ID: "month.short.3", Description: "Short name of March", Other: "Mar"},
+		{ID: "month.short.4", Description: "Short name of April", Other: "Apr"},
+		{ID: "month.short.5", Description: "Short name of May", Other: "May"},
+		{ID: "month.short.6", Description: "Short name of June", Other: "Jun"},
+		{ID: "month.short.7", Description: "Short name of July", Other: "Jul"},
+		{ID: "month.short.8", Description: "Short name of August", Other: "Aug"},
+		{ID: "month.short.9", Description: "Short name of September", Other: "Sep"},
+		{ID: "month.short.10", Description: "Short name of October", Other: "Oct"},
+		{ID: "month.short.11", Description: "Short name of November", Other: "Nov"},
+		{ID: "month.short.12", Description: "Short name of December", Other: "Dec"},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "Daily"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "Every {{.Count}} days"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "the last day"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "day {{.Day}}"},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "Monthly"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "Every {{.Count}} months"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "1st"},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "2nd"},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "3rd"},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "4th"},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "5th"},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "last"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "the {{.Nth}} {{.Weekday}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}} on {{.Days}}"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "once"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", Other: "{{.Count}} times"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "Weekly"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "Every {{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "Every {{.Count}} weeks"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "Every {{.Count}} weeks on {{.Days}}"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "Yearly"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "Every {{.Count}} years"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", One: "in {{.Count}} day", Other: "in {{.Count}} days"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", One: "in {{.Count}} hour", Other: "in {{.Count}} hours"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", One: "in {{.Count}} minute", Other: "in {{.Count}} minutes"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", One: "in {{.Count}} month", Other: "in {{.Count}} months"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", One: "in {{.Count}} week", Other: "in {{.Count}} weeks"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", One: "in {{.Count}} year", Other: "in {{.Count}} years"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "now"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", One: "{{.Count}} day ago", Other: "{{.Count}} days ago"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", One: "{{.Count}} hour ago", Other: "{{.Count}} hours ago"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", One: "{{.Count}} minute ago", Other: "{{.Count}} minutes ago"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", One: "{{.Count}} month ago", Other: "{{.Count}} months ago"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", One: "{{.Count}} week ago", Other: "{{.Count}} weeks ago"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", One: "{{.Count}} year ago", Other: "{{.Count}} years ago"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "Sunday"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "Monday"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "Tuesday"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "Wednesday"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "Thursday"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "Friday"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "Saturday"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "Sun"},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "Mon"},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "Tue"},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "Wed"},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "Thu"},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "Fri"},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "Sat"},
+	},
+	"ar": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "{{.Time}} ص"},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{.Day}} {{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "{{.Time}} م"},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "الربع {{.Quarter}} من {{.Year}}"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "من {{.From}} إلى {{.To}}"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Weekday}}، {{.Date}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Month}} {{.Day}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "1"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "2"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "3"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "4"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "5"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "6"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "7"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "8"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "9"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "10"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "11"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "12"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "13"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "14"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "15"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "16"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "17"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "18"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "19"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "20"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "21"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "22"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "23"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "24"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "25"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "26"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "27"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "28"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "29"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "30"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Day}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "{{.Month}}bis"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "M01"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "M02"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "M03"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "M04"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "M05"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "M06"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M07"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "M08"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "M09"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "M10"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M11"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "M12"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "M01"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "M02"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "M03"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "M04"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "M05"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "M06"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M07"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "M08"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "M09"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "M10"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M11"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "M12"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "تشري"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "مرحشوان"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "كيسلو"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "طيفت"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "شباط"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "آذار الأول"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "آذار"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "آذار الثاني"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "نيسان"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "أيار"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "سيفان"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "تموز"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "آب"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "أيلول"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "تشري"},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "مرحشوان"},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "كيسلو"},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "طيفت"},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "شباط"},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "آذار الأول"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "آذار"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "آذار الثاني"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "نيسان"},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "أيار"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "سيفان"},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "تموز"},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "آب"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "أيلول"},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "محرم"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "صفر"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "ربيع الأول"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "ربيع الآخر"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "جمادى الأولى"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "جمادى الآخرة"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "رجب"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "شعبان"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "رمضان"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "شوال"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "ذو القعدة"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "ذو الحجة"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "محرم"},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "صفر"},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "ربيع الأول"},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "ربيع الآخر"},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "جمادى الأولى"},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "جمادى الآخرة"},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "رجب"},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "شعبان"},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "رمضان"},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "شوال"},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "ذو القعدة"},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "ذو الحجة"},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "فرفردن"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "أذربيهشت"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "خرداد"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "تار"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "مرداد"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "شهرفار"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "مهر"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "آيان"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "آذر"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "دي"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "بهمن"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "اسفندار"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "فرفردن"},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "أذربيهشت"},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "خرداد"},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "تار"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "مرداد"},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "شهرفار"},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "مهر"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "آيان"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "آذر"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "دي"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "بهمن"},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "اسفندار"},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: "، "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", Zero: "{{.Count}} يوم", One: "يوم", Two: "يومان", Few: "{{.Count}} أيام", Many: "{{.Count}} يوم", Other: "{{.Count}} يوم"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", Zero: "{{.Count}} ساعة", One: "ساعة", Two: "ساعتان", Few: "{{.Count}} ساعات", Many: "{{.Count}} ساعة", Other: "{{.Count}} ساعة"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", Zero: "{{.Count}} دقيقة", One: "دقيقة", Two: "دقيقتان", Few: "{{.Count}} دقائق", Many: "{{.Count}} دقيقة", Other: "{{.Count}} دقيقة"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}} و{{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", Zero: "{{.Count}} ثانية", One: "ثانية", Two: "ثانيتان", Few: "{{.Count}} ثوانٍ", Many: "{{.Count}} ثانية", Other: "{{.Count}} ثانية"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}} و{{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}، {{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "يناير"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "فبراير"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "مارس"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "أبريل"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "مايو"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "يونيو"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "يوليو"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "أغسطس"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "سبتمبر"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "أكتوبر"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "نوفمبر"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "ديسمبر"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "يناير"},
+		{ID: "month.short.2", Description: "Short name of February", Other: "فبراير"},
+		{ID: "month.short.3", Description: "Short name of March", Other: "مارس"},
+		{ID: "month.short.4", Description: "Short name of April", Other: "أبريل"},
+		{ID: "month.short.5", Description: "Short name of May", Other: "مايو"},
+		{ID: "month.short.6", Description: "Short name of June", Other: "يونيو"},
+		{ID: "month.short.7", Description: "Short name of July", Other: "يوليو"},
+		{ID: "month.short.8", Description: "Short name of August", Other: "أغسطس"},
+		{ID: "month.short.9", Description: "Short name of September", Other: "سبتمبر"},
+		{ID: "month.short.10", Description: "Short name of October", Other: "أكتوبر"},
+		{ID: "month.short.11", Description: "Short name of November", Other: "نوفمبر"},
+		{ID: "month.short.12", Description: "Short name of December", Other: "ديسمبر"},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "يوميًا"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "كل {{.Count}} يوم"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "اليوم الأخير"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "اليوم {{.Day}}"},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "شهريًا"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "كل {{.Count}} شهر"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "الأول"},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "الثاني"},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "الثالث"},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "الرابع"},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "الخامس"},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "الأخير"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "{{.Weekday}} {{.Nth}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}} في {{.Days}}"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "مرة واحدة"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", Zero: "{{.Count}} مرة", One: "مرة", Two: "مرتين", Few: "{{.Count}} مرات", Many: "{{.Count}} مرة", Other: "{{.Count}} مرة"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "أسبوعيًا"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "كل {{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "كل {{.Count}} أسبوع"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "كل {{.Count}} أسبوع يوم {{.Days}}"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "سنويًا"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "كل {{.Count}} سنة"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", Zero: "خلال {{.Count}} يوم", One: "خلال يوم واحد", Two: "خلال يومين", Few: "خلال {{.Count}} أيام", Many: "خلال {{.Count}} يوم", Other: "خلال {{.Count}} يوم"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", Zero: "خلال {{.Count}} ساعة", One: "خلال ساعة واحدة", Two: "خلال ساعتين", Few: "خلال {{.Count}} ساعات", Many: "خلال {{.Count}} ساعة", Other: "خلال {{.Count}} ساعة"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", Zero: "خلال {{.Count}} دقيقة", One: "خلال دقيقة واحدة", Two: "خلال دقيقتين", Few: "خلال {{.Count}} دقائق", Many: "خلال {{.Count}} دقيقة", Other: "خلال {{.Count}} دقيقة"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", Zero: "خلال {{.Count}} شهر", One: "خلال شهر واحد", Two: "خلال شهرين", Few: "خلال {{.Count}} أشهر", Many: "خلال {{.Count}} شهر", Other: "خلال {{.Count}} شهر"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", Zero: "خلال {{.Count}} أسبوع", One: "خلال أسبوع واحد", Two: "خلال أسبوعين", Few: "خلال {{.Count}} أسابيع", Many: "خلال {{.Count}} أسبوع", Other: "خلال {{.Count}} أسبوع"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", Zero: "خلال {{.Count}} سنة", One: "خلال سنة واحدة", Two: "خلال سنتين", Few: "خلال {{.Count}} سنوات", Many: "خلال {{.Count}} سنة", Other: "خلال {{.Count}} سنة"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "الآن"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", Zero: "قبل {{.Count}} يوم", One: "قبل يوم واحد", Two: "قبل يومين", Few: "قبل {{.Count}} أيام", Many: "قبل {{.Count}} يوم", Other: "قبل {{.Count}} يوم"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", Zero: "قبل {{.Count}} ساعة", One: "قبل ساعة واحدة", Two: "قبل ساعتين", Few: "قبل {{.Count}} ساعات", Many: "قبل {{.Count}} ساعة", Other: "قبل {{.Count}} ساعة"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", Zero: "قبل {{.Count}} دقيقة", One: "قبل دقيقة واحدة", Two: "قبل دقيقتين", Few: "قبل {{.Count}} دقائق", Many: "قبل {{.Count}} دقيقة", Other: "قبل {{.Count}} دقيقة"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", Zero: "قبل {{.Count}} شهر", One: "قبل شهر واحد", Two: "قبل شهرين", Few: "قبل {{.Count}} أشهر", Many: "قبل {{.Count}} شهر", Other: "قبل {{.Count}} شهر"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", Zero: "قبل {{.Count}} أسبوع", One: "قبل أسبوع واحد", Two: "قبل أسبوعين", Few: "قبل {{.Count}} أسابيع", Many: "قبل {{.Count}} أسبوع", Other: "قبل {{.Count}} أسبوع"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", Zero: "قبل {{.Count}} سنة", One: "قبل سنة واحدة", Two: "قبل سنتين", Few: "قبل {{.Count}} سنوات", Many: "قبل {{.Count}} سنة", Other: "قبل {{.Count}} سنة"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "الأحد"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "الاثنين"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "الثلاثاء"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "الأربعاء"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "الخميس"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "الجمعة"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "السبت"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "الأحد"},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "الاثنين"},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "الثلاثاء"},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "الأربعاء"},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "الخميس"},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "الجمعة"},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "السبت"},
+	},
+	"de": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "{{.Time}} AM"},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{.Day}}. {{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "{{.Time}} PM"},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "{{.Quarter}}. Quartal {{.Year}}"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "{{.From}} bis {{.To}}"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Weekday}}, {{.Date}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Month}} {{.Day}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "1"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "2"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "3"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "4"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "5"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "6"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "7"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "8"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "9"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "10"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "11"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "12"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "13"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "14"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "15"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "16"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "17"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "18"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "19"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "20"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "21"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "22"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "23"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "24"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "25"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "26"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "27"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "28"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "29"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "30"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Day}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "{{.Month}} bis"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "Erster Monat"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "Zweiter Monat"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "Dritter Monat"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "Vierter Monat"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "Fünfter Monat"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "Sechster Monat"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "Siebter Monat"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "Achter Monat"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "Neunter Monat"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "Zehnter Monat"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "Elfter Monat"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "Zwölfter Monat"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "M01"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "M02"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "M03"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "M04"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "M05"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "M06"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M07"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "M08"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "M09"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "M10"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M11"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "M12"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "Tischri"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "Cheschwan"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "Kislew"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "Tevet"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "Schevat"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "Adar I"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "Adar"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "Adar II"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "Nisan"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "Ijjar"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "Siwan"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "Tammus"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "Aw"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "Elul"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "Tischri"},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "Cheschwan"},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "Kislew"},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "Tevet"},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "Schevat"},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "Adar I"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "Adar"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "Adar II"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "Nisan"},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "Ijjar"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "Siwan"},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "Tammus"},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "Aw"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "Elul"},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "Muharram"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "Safar"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "Rabiʻ I"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "Rabiʻ II"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "Dschumada I"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "Dschumada II"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "Radschab"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "Shaʻban"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "Ramadan"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "Shawwal"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "Dhu l-qaʿda"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "Dhu l-Hiddscha"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "Muh."},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "Saf."},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "Rab. I"},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "Rab. II"},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "Jum. I"},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "Jum. II"},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "Raj."},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "Sha."},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "Ram."},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "Shaw."},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "Dhuʻl-Q."},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "Dhuʻl-H."},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "Farwardin"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "Ordibehescht"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "Chordād"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "Tir"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "Mordād"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "Schahriwar"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "Mehr"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "Ābān"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "Āsar"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "Déi"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "Bahman"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "Essfand"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "Farwardin"},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "Ordibehescht"},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "Chordād"},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "Tir"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "Mordād"},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "Schahriwar"},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "Mehr"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "Ābān"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "Āsar"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "Déi"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "Bahman"},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "Essfand"},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: ", "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", One: "{{.Count}} Tag", Other: "{{.Count}} Tage"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", One: "{{.Count}} Stunde", Other: "{{.Count}} Stunden"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", One: "{{.Count}} Minute", Other: "{{.Count}} Minuten"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}} {{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", One: "{{.Count}} Sekunde", Other: "{{.Count}} Sekunden"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}} und {{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}, {{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "Januar"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "Februar"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "März"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "April"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "Mai"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "Juni"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "Juli"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "August"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "September"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "Oktober"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "November"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "Dezember"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "Jan"},
+		{ID: "month.short.2", Description: "Short name of February", Other: "Feb"},
+		{ID: "month.short.3", Description: "Short name of March", Other: "Mär"},
+		{ID: "month.short.4", Description: "Short name of April", Other: "Apr"},
+		{ID: "month.short.5", Description: "Short name of May", Other: "Mai"},
+		{ID: "month.short.6", Description: "Short name of June", Other: "Jun"},
+		{ID: "month.short.7", Description: "Short name of July", Other: "Jul"},
+		{ID: "month.short.8", Description: "Short name of August", Other: "Aug"},
+		{ID: "month.short.9", Description: "Short name of September", Other: "Sep"},
+		{ID: "month.short.10", Description: "Short name of October", Other: "Okt"},
+		{ID: "month.short.11", Description: "Short name of November", Other: "Nov"},
+		{ID: "month.short.12", Description: "Short name of December", Other: "Dez"},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "Täglich"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "Alle {{.Count}} Tage"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "letzten Tag"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "{{.Day}}."},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "Monatlich"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "Alle {{.Count}} Monate"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "1."},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "2."},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "3."},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "4."},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "5."},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "letzten"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "{{.Nth}} {{.Weekday}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}} am {{.Days}}"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "einmal"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", Other: "{{.Count}}-mal"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "Wöchentlich"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "Jeden {{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "Alle {{.Count}} Wochen"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "Alle {{.Count}} Wochen am {{.Days}}"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "Jährlich"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "Alle {{.Count}} Jahre"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", One: "in {{.Count}} Tag", Other: "in {{.Count}} Tagen"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", One: "in {{.Count}} Stunde", Other: "in {{.Count}} Stunden"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", One: "in {{.Count}} Minute", Other: "in {{.Count}} Minuten"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", One: "in {{.Count}} Monat", Other: "in {{.Count}} Monaten"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", One: "in {{.Count}} Woche", Other: "in {{.Count}} Wochen"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", One: "in {{.Count}} Jahr", Other: "in {{.Count}} Jahren"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "jetzt"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", One: "vor {{.Count}} Tag", Other: "vor {{.Count}} Tagen"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", One: "vor {{.Count}} Stunde", Other: "vor {{.Count}} Stunden"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", One: "vor {{.Count}} Minute", Other: "vor {{.Count}} Minuten"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", One: "vor {{.Count}} Monat", Other: "vor {{.Count}} Monaten"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", One: "vor {{.Count}} Woche", Other: "vor {{.Count}} Wochen"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", One: "vor {{.Count}} Jahr", Other: "vor {{.Count}} Jahren"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "Sonntag"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "Montag"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "Dienstag"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "Mittwoch"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "Donnerstag"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "Freitag"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "Samstag"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "So"},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "Mo"},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "Di"},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "Mi"},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "Do"},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "Fr"},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "Sa"},
+	},
+	"es": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "{{.Time}} a. m."},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{.Day}} de {{.Month}}{{if .Year}} de {{.Year}}{{end}}"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{.Month}}{{if .Year}} de {{.Year}}{{end}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "{{.Time}} p. m."},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "trimestre {{.Quarter}} de {{.Year}}"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "del {{.From}} al {{.To}}"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Weekday}}, {{.Date}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Month}} {{.Day}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "1"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "2"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "3"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "4"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "5"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "6"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "7"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "8"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "9"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "10"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "11"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "12"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "13"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "14"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "15"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "16"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "17"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "18"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "19"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "20"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "21"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "22"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "23"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "24"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "25"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "26"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "27"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "28"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "29"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "30"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Day}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "{{.Month}} bis"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "Primer mes"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "Segundo mes"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "Tercer mes"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "Cuarto mes"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "Quinto mes"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "Sexto mes"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "Séptimo mes"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "Octavo mes"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "Noveno mes"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "Décimo mes"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "Undécimo mes"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "Duodécimo mes"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "M01"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "M02"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "M03"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "M04"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "M05"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "M06"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M07"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "M08"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "M09"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "M10"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M11"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "M12"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "tishri"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "heshvan"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "kislev"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "tevet"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "shevat"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "adar I"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "adar"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "adar II"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "nisan"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "iyar"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "sivan"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "tamuz"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "av"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "elul"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "tishri"},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "heshvan"},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "kislev"},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "tevet"},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "shevat"},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "adar I"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "adar"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "adar II"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "nisan"},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "iyar"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "sivan"},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "tamuz"},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "av"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "elul"},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "muharram"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "safar"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "rabiʻ I"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "rabiʻ II"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "jumada I"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "jumada II"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "rajab"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "shaʻban"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "ramadán"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "shawwal"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "dhuʻl-qiʻdah"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "dhuʻl-hijjah"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "muh."},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "saf."},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "rab. I"},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "rab. II"},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "jum. I"},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "jum. II"},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "raj."},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "sha."},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "ram."},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "shaw."},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "dhuʻl-q."},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "dhuʻl-h."},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "farvardin"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "ordibehesht"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "khordad"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "tir"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "mordad"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "shahrivar"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "mehr"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "aban"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "azar"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "dey"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "bahman"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "esfand"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "farvardin"},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "ordibehesht"},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "khordad"},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "tir"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "mordad"},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "shahrivar"},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "mehr"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "aban"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "azar"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "dey"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "bahman"},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "esfand"},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: ", "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", One: "{{.Count}} día", Other: "{{.Count}} días"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", One: "{{.Count}} hora", Other: "{{.Count}} horas"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", One: "{{.Count}} minuto", Other: "{{.Count}} minutos"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}} {{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", One: "{{.Count}} segundo", Other: "{{.Count}} segundos"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}} y {{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}, {{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "Enero"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "Febrero"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "Marzo"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "Abril"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "Mayo"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "Junio"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "Julio"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "Agosto"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "Septiembre"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "Octubre"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "Noviembre"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "Diciembre"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "Ene"},
+		{ID: "month.short.2", Description: "Short name of February", Other: "Feb"},
+		{ID: "month.short.3", Description: "Short name of March", Other: "Mar"},
+		{ID: "month.short.4", Description: "Short name of April", Other: "Abr"},
+		{ID: "month.short.5", Description: "Short name of May", Other: "May"},
+		{ID: "month.short.6", Description: "Short name of June", Other: "Jun"},
+		{ID: "month.short.7", Description: "Short name of July", Other: "Jul"},
+		{ID: "month.short.8", Description: "Short name of August", Other: "Ago"},
+		{ID: "month.short.9", Description: "Short name of September", Other: "Sep"},
+		{ID: "month.short.10", Description: "Short name of October", Other: "Oct"},
+		{ID: "month.short.11", Description: "Short name of November", Other: "Nov"},
+		{ID: "month.short.12", Description: "Short name of December", Other: "Dic"},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "Diariamente"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "Cada {{.Count}} días"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "último día"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "día {{.Day}}"},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "Mensualmente"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "Cada {{.Count}} meses"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "primer"},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "segundo"},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "tercer"},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "cuarto"},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "quinto"},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "último"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "{{.Nth}} {{.Weekday}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}} el {{.Days}}"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "una vez"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", Other: "{{.Count}} veces"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "Semanalmente"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "Cada {{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "Cada {{.Count}} semanas"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "Cada {{.Count}} semanas el {{.Days}}"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "Anualmente"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "Cada {{.Count}} años"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", One: "dentro de {{.Count}} día", Other: "dentro de {{.Count}} días"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", One: "dentro de {{.Count}} hora", Other: "dentro de {{.Count}} horas"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", One: "dentro de {{.Count}} minuto", Other: "dentro de {{.Count}} minutos"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", One: "dentro de {{.Count}} mes", Other: "dentro de {{.Count}} meses"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", One: "dentro de {{.Count}} semana", Other: "dentro de {{.Count}} semanas"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", One: "dentro de {{.Count}} año", Other: "dentro de {{.Count}} años"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "ahora"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", One: "hace {{.Count}} día", Other: "hace {{.Count}} días"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", One: "hace {{.Count}} hora", Other: "hace {{.Count}} horas"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", One: "hace {{.Count}} minuto", Other: "hace {{.Count}} minutos"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", One: "hace {{.Count}} mes", Other: "hace {{.Count}} meses"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", One: "hace {{.Count}} semana", Other: "hace {{.Count}} semanas"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", One: "hace {{.Count}} año", Other: "hace {{.Count}} años"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "Domingo"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "Lunes"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "Martes"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "Miércoles"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "Jueves"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "Viernes"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "Sábado"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "Dom"},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "Lun"},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "Mar"},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "Mié"},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "Jue"},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "Vie"},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "Sáb"},
+	},
+	"fa": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "{{.Time}} ق.ظ."},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{.Day}} {{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "{{.Time}} ب.ظ."},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "سه\u200cماهه {{.Quarter}} سال {{.Year}}"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "از {{.From}} تا {{.To}}"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Weekday}} {{.Date}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Month}} {{.Day}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "1"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "2"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "3"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "4"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "5"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "6"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "7"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "8"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "9"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "10"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "11"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "12"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "13"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "14"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "15"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "16"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "17"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "18"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "19"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "20"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "21"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "22"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "23"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "24"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "25"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "26"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "27"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "28"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "29"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "30"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Day}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "{{.Month}}bis"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "M01"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "M02"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "M03"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "M04"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "M05"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "M06"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M07"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "M08"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "M09"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "M10"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M11"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "M12"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "M01"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "M02"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "M03"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "M04"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "M05"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "M06"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M07"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "M08"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "M09"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "M10"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M11"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "M12"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "تشری"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "حشوان"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "کسلو"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "طوت"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "شباط"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "آذار"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "واذار"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "واذار الثانی"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "نیسان"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "ایار"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "سیوان"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "تموز"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "آب"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "ایلول"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "تشری"},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "حشوان"},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "کسلو"},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "طوت"},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "شباط"},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "آذار"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "واذار"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "واذار الثانی"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "نیسان"},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "ایار"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "سیوان"},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "تموز"},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "آب"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "ایلول"},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "محرم"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "صفر"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "ربیع\u200cالاول"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "ربیع\u200cالثانی"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "جمادی\u200cالاول"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "جمادی\u200cالثانی"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "رجب"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "شعبان"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "رمضان"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "شوال"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "ذیقعده"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "ذیحجه"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "محرم"},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "صفر"},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "ربیع\u200cالاول"},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "ربیع\u200cالثانی"},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "جمادی\u200cالاول"},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "جمادی\u200cالثانی"},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "رجب"},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "شعبان"},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "رمضان"},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "شوال"},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "ذیقعده"},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "ذیحجه"},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "فروردین"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "اردیبهشت"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "خرداد"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "تیر"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "مرداد"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "شهریور"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "مهر"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "آبان"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "آذر"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "دی"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "بهمن"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "اسفند"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "فروردین"},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "اردیبهشت"},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "خرداد"},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "تیر"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "مرداد"},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "شهریور"},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "مهر"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "آبان"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "آذر"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "دی"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "بهمن"},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "اسفند"},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: "، "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", One: "{{.Count}} روز", Other: "{{.Count}} روز"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", One: "{{.Count}} ساعت", Other: "{{.Count}} ساعت"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", One: "{{.Count}} دقیقه", Other: "{{.Count}} دقیقه"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}} و {{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", One: "{{.Count}} ثانیه", Other: "{{.Count}} ثانیه"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}} و {{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}، {{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "ژانویه"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "فوریه"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "مارس"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "آوریل"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "مه"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "ژوئن"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "ژوئیه"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "اوت"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "سپتامبر"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "اکتبر"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "نوامبر"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "دسامبر"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "ژانویه"},
+		{ID: "month.short.2", Description: "Short name of February", Other: "فوریه"},
+		{ID: "month.short.3", Description: "Short name of March", Other: "مارس"},
+		{ID: "month.short.4", Description: "Short name of April", Other: "آوریل"},
+		{ID: "month.short.5", Description: "Short name of May", Other: "مه"},
+		{ID: "month.short.6", Description: "Short name of June", Other: "ژوئن"},
+		{ID: "month.short.7", Description: "Short name of July", Other: "ژوئیه"},
+		{ID: "month.short.8", Description: "Short name of August", Other: "اوت"},
+		{ID: "month.short.9", Description: "Short name of September", Other: "سپتامبر"},
+		{ID: "month.short.10", Description: "Short name of October", Other: "اکتبر"},
+		{ID: "month.short.11", Description: "Short name of November", Other: "نوامبر"},
+		{ID: "month.short.12", Description: "Short name of December", Other: "دسامبر"},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "روزانه"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "هر {{.Count}} روز"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "آخرین روز"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "روز {{.Day}}"},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "ماهانه"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "هر {{.Count}} ماه"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "اول"},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "دوم"},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "سوم"},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "چهارم"},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "پنجم"},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "آخر"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "{{.Weekday}} {{.Nth}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}} در {{.Days}}"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "یک بار"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", Other: "{{.Count}} بار"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "هفتگی"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "هر {{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "هر {{.Count}} هفته"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "هر {{.Count}} هفته در {{.Days}}"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "سالانه"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "هر {{.Count}} سال"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", One: "{{.Count}} روز بعد", Other: "{{.Count}} روز بعد"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", One: "{{.Count}} ساعت بعد", Other: "{{.Count}} ساعت بعد"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", One: "{{.Count}} دقیقه بعد", Other: "{{.Count}} دقیقه بعد"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", One: "{{.Count}} ماه بعد", Other: "{{.Count}} ماه بعد"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", One: "{{.Count}} هفته بعد", Other: "{{.Count}} هفته بعد"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", One: "{{.Count}} سال بعد", Other: "{{.Count}} سال بعد"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "اکنون"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", One: "{{.Count}} روز پیش", Other: "{{.Count}} روز پیش"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", One: "{{.Count}} ساعت پیش", Other: "{{.Count}} ساعت پیش"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", One: "{{.Count}} دقیقه پیش", Other: "{{.Count}} دقیقه پیش"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", One: "{{.Count}} ماه پیش", Other: "{{.Count}} ماه پیش"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", One: "{{.Count}} هفته پیش", Other: "{{.Count}} هفته پیش"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", One: "{{.Count}} سال پیش", Other: "{{.Count}} سال پیش"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "یکشنبه"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "دوشنبه"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "سه\u200cشنبه"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "چهارشنبه"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "پنجشنبه"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "جمعه"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "شنبه"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "یکشنبه"},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "دوشنبه"},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "سه\u200cشنبه"},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "چهارشنبه"},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "پنجشنبه"},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "جمعه"},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "شنبه"},
+	},
+	"fr": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "{{.Time}} AM"},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{.Day}} {{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "{{.Time}} PM"},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "trimestre {{.Quarter}} {{.Year}}"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "du {{.From}} au {{.To}}"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Weekday}} {{.Date}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Month}} {{.Day}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "1"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "2"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "3"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "4"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "5"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "6"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "7"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "8"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "9"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "10"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "11"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "12"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "13"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "14"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "15"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "16"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "17"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "18"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "19"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "20"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "21"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "22"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "23"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "24"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "25"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "26"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "27"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "28"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "29"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "30"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Day}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "{{.Month}} bis"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "zhēngyuè"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "èryuè"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "sānyuè"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "sìyuè"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "wǔyuè"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "liùyuè"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "qīyuè"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "bāyuè"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "jiǔyuè"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "shíyuè"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "shíyīyuè"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "shí’èryuè"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "1yuè"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "2yuè"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "3yuè"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "4yuè"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "5yuè"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "6yuè"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "7yuè"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "8yuè"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "9yuè"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "10yuè"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "11yuè"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "12yuè"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "tichri"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "hèchvan"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "kislev"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "téveth"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "chevat"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "adar I"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "adar"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "adar II"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "nissan"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "iyar"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "sivan"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "tamouz"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "av"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "éloul"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "tich."},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "hèch."},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "kis."},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "tév."},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "chev."},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "ad.I"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "adar"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "ad.II"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "nis."},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "iyar"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "siv."},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "tam."},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "av"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "él."},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "mouharram"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "safar"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "rabia al awal"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "rabia ath-thani"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "joumada al oula"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "joumada ath-thania"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "rajab"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "chaabane"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "ramadan"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "chawwal"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "dhou al qiʿda"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "dhou al-hijja"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "mouh."},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "saf."},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "rab. aw."},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "rab. th."},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "joum. ou."},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "joum. th."},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "raj."},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "chaa."},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "ram."},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "chaw."},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "dhou. qi."},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "dhou. hi."},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "farvardin"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "ordibehešt"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "khordâd"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "tir"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "mordâd"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "šahrivar"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "mehr"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "âbân"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "âzar"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "dey"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "bahman"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "esfand"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "far."},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "ord."},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "kho."},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "tir"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "mor."},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "šah."},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "mehr"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "âbân"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "âzar"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "dey"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "bah."},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "esf."},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: ", "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", One: "{{.Count}} jour", Other: "{{.Count}} jours"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", One: "{{.Count}} heure", Other: "{{.Count}} heures"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", One: "{{.Count}} minute", Other: "{{.Count}} minutes"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}} {{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", One: "{{.Count}} seconde", Other: "{{.Count}} secondes"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}} et {{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}, {{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "janvier"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "février"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "mars"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "avril"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "mai"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "juin"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "juillet"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "août"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "septembre"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "octobre"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "novembre"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "décembre"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "janv."},
+		{ID: "month.short.2", Description: "Short name of February", Other: "févr."},
+		{ID: "month.short.3", Description: "Short name of March", Other: "mars"},
+		{ID: "month.short.4", Description: "Short name of April", Other: "avr."},
+		{ID: "month.short.5", Description: "Short name of May", Other: "mai"},
+		{ID: "month.short.6", Description: "Short name of June", Other: "juin"},
+		{ID: "month.short.7", Description: "Short name of July", Other: "juil."},
+		{ID: "month.short.8", Description: "Short name of August", Other: "août"},
+		{ID: "month.short.9", Description: "Short name of September", Other: "sept."},
+		{ID: "month.short.10", Description: "Short name of October", Other: "oct."},
+		{ID: "month.short.11", Description: "Short name of November", Other: "nov."},
+		{ID: "month.short.12", Description: "Short name of December", Other: "déc."},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "Tous les jours"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "Tous les {{.Count}} jours"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "dernier jour"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "{{.Day}}"},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "Tous les mois"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "Tous les {{.Count}} mois"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "1er"},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "2e"},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "3e"},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "4e"},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "5e"},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "dernier"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "{{.Nth}} {{.Weekday}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}} le {{.Days}}"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "une fois"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", Other: "{{.Count}} fois"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "Toutes les semaines"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "Chaque {{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "Toutes les {{.Count}} semaines"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "Toutes les {{.Count}} semaines le {{.Days}}"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "Tous les ans"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "Tous les {{.Count}} ans"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", One: "dans {{.Count}} jour", Other: "dans {{.Count}} jours"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", One: "dans {{.Count}} heure", Other: "dans {{.Count}} heures"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", One: "dans {{.Count}} minute", Other: "dans {{.Count}} minutes"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", One: "dans {{.Count}} mois", Other: "dans {{.Count}} mois"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", One: "dans {{.Count}} semaine", Other: "dans {{.Count}} semaines"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", One: "dans {{.Count}} an", Other: "dans {{.Count}} ans"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "maintenant"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", One: "il y a {{.Count}} jour", Other: "il y a {{.Count}} jours"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", One: "il y a {{.Count}} heure", Other: "il y a {{.Count}} heures"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", One: "il y a {{.Count}} minute", Other: "il y a {{.Count}} minutes"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", One: "il y a {{.Count}} mois", Other: "il y a {{.Count}} mois"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", One: "il y a {{.Count}} semaine", Other: "il y a {{.Count}} semaines"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", One: "il y a {{.Count}} an", Other: "il y a {{.Count}} ans"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "dimanche"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "lundi"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "mardi"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "mercredi"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "jeudi"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "vendredi"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "samedi"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "dim."},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "lun."},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "mar."},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "mer."},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "jeu."},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "ven."},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "sam."},
+	},
+	"he": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "{{.Time}} לפנה״צ"},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{.Day}} ב{{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "{{.Time}} אחה״צ"},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "רבעון {{.Quarter}} {{.Year}}"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "{{.From}} עד {{.To}}"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Weekday}}, {{.Date}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Month}} {{.Day}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "1"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "2"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "3"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "4"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "5"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "6"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "7"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "8"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "9"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "10"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "11"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "12"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "13"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "14"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "15"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "16"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "17"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "18"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "19"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "20"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "21"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "22"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "23"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "24"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "25"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "26"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "27"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "28"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "29"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "30"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Day}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "{{.Month}}bis"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "M01"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "M02"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "M03"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "M04"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "M05"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "M06"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M07"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "M08"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "M09"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "M10"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M11"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "M12"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "M01"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "M02"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "M03"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "M04"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "M05"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "M06"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M07"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "M08"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "M09"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "M10"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M11"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "M12"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "תשרי"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "חשוון"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "כסלו"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "טבת"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "שבט"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "אדר א׳"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "אדר"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "אדר ב׳"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "ניסן"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "אייר"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "סיוון"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "תמוז"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "אב"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "אלול"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "תשרי"},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "חשון"},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "כסלו"},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "טבת"},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "שבט"},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "אדר א׳"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "אדר"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "אדר ב׳"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "ניסן"},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "אייר"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "סיון"},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "תמוז"},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "אב"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "אלול"},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "מוחרם"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "צפר"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "רביע אל־אוול"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "רביע א־ת׳אני"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "ג׳ומאדא אל־אולא"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "ג׳ומאדא א־ת׳אניה"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "רג׳ב"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "שעבאן"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "רמדאן"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "שוואל"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "ד׳ו אל־קעדה"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "ד׳ו אל־חיג׳ה"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "מוחרם"},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "צפר"},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "רביע א׳"},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "רביע ב׳"},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "ג׳ומאדא א׳"},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "ג׳ומאדא ב׳"},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "רג׳ב"},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "שעבאן"},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "רמדאן"},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "שוואל"},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "ד׳ו אל־קעדה"},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "ד׳ו אל־חיג׳ה"},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "פרורדין"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "ארדיבהשת"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "ח׳רדאד"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "תיר"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "מרדאד"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "שהריור"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "מהר"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "אבאן"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "אד׳ר"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "די"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "בהמן"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "אספנד"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "פרורדין"},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "ארדיבהשת"},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "ח׳רדאד"},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "תיר"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "מרדאד"},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "שהריור"},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "מהר"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "אבאן"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "אד׳ר"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "די"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "בהמן"},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "אספנד"},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: ", "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", One: "יום", Two: "יומיים", Many: "{{.Count}} ימים", Other: "{{.Count}} ימים"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", One: "שעה", Two: "שעתיים", Many: "{{.Count}} שעות", Other: "{{.Count}} שעות"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", One: "דקה", Two: "{{.Count}} דקות", Many: "{{.Count}} דקות", Other: "{{.Count}} דקות"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}} ו{{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", One: "שנייה", Two: "{{.Count}} שניות", Many: "{{.Count}} שניות", Other: "{{.Count}} שניות"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}} ו{{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}, {{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "ינואר"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "פברואר"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "מרץ"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "אפריל"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "מאי"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "יוני"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "יולי"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "אוגוסט"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "ספטמבר"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "אוקטובר"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "נובמבר"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "דצמבר"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "ינו׳"},
+		{ID: "month.short.2", Description: "Short name of February", Other: "פבר׳"},
+		{ID: "month.short.3", Description: "Short name of March", Other: "מרץ"},
+		{ID: "month.short.4", Description: "Short name of April", Other: "אפר׳"},
+		{ID: "month.short.5", Description: "Short name of May", Other: "מאי"},
+		{ID: "month.short.6", Description: "Short name of June", Other: "יוני"},
+		{ID: "month.short.7", Description: "Short name of July", Other: "יולי"},
+		{ID: "month.short.8", Description: "Short name of August", Other: "אוג׳"},
+		{ID: "month.short.9", Description: "Short name of September", Other: "ספט׳"},
+		{ID: "month.short.10", Description: "Short name of October", Other: "אוק׳"},
+		{ID: "month.short.11", Description: "Short name of November", Other: "נוב׳"},
+		{ID: "month.short.12", Description: "Short name of December", Other: "דצמ׳"},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "כל יום"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "כל {{.Count}} ימים"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "היום האחרון"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "יום {{.Day}}"},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "כל חודש"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "כל {{.Count}} חודשים"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "ראשון"},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "שני"},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "שלישי"},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "רביעי"},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "חמישי"},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "אחרון"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "{{.Weekday}} ה{{.Nth}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}} ב{{.Days}}"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "פעם אחת"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", One: "פעם אחת", Two: "פעמיים", Many: "{{.Count}} פעמים", Other: "{{.Count}} פעמים"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "כל שבוע"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "כל {{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "כל {{.Count}} שבועות"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "כל {{.Count}} שבועות ב{{.Days}}"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "כל שנה"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "כל {{.Count}} שנים"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", One: "בעוד יום", Two: "בעוד יומיים", Many: "בעוד {{.Count}} ימים", Other: "בעוד {{.Count}} ימים"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", One: "בעוד שעה", Two: "בעוד שעתיים", Many: "בעוד {{.Count}} שעות", Other: "בעוד {{.Count}} שעות"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", One: "בעוד דקה", Two: "בעוד {{.Count}} דקות", Many: "בעוד {{.Count}} דקות", Other: "בעוד {{.Count}} דקות"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", One: "בעוד חודש", Two: "בעוד חודשיים", Many: "בעוד {{.Count}} חודשים", Other: "בעוד {{.Count}} חודשים"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", One: "בעוד שבוע", Two: "בעוד שבועיים", Many: "בעוד {{.Count}} שבועות", Other: "בעוד {{.Count}} שבועות"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", One: "בעוד שנה", Two: "בעוד שנתיים", Many: "בעוד {{.Count}} שנים", Other: "בעוד {{.Count}} שנים"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "עכשיו"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", One: "לפני יום", Two: "לפני יומיים", Many: "לפני {{.Count}} ימים", Other: "לפני {{.Count}} ימים"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", One: "לפני שעה", Two: "לפני שעתיים", Many: "לפני {{.Count}} שעות", Other: "לפני {{.Count}} שעות"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", One: "לפני דקה", Two: "לפני {{.Count}} דקות", Many: "לפני {{.Count}} דקות", Other: "לפני {{.Count}} דקות"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", One: "לפני חודש", Two: "לפני חודשיים", Many: "לפני {{.Count}} חודשים", Other: "לפני {{.Count}} חודשים"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", One: "לפני שבוע", Two: "לפני שבועיים", Many: "לפני {{.Count}} שבועות", Other: "לפני {{.Count}} שבועות"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", One: "לפני שנה", Two: "לפני שנתיים", Many: "לפני {{.Count}} שנים", Other: "לפני {{.Count}} שנים"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "יום ראשון"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "יום שני"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "יום שלישי"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "יום רביעי"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "יום חמישי"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "יום שישי"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "יום שבת"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "יום א׳"},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "יום ב׳"},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "יום ג׳"},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "יום ד׳"},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "יום ה׳"},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "יום ו׳"},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "שבת"},
+	},
+	"ja": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "午前{{.Hour}}時{{if .Minute}}{{.Minute}}分{{end}}"},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{if .Year}}{{.Year}}年{{end}}{{.Month}}{{.Day}}日"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{if .Year}}{{.Year}}年{{end}}{{.Month}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "午後{{.Hour}}時{{if .Minute}}{{.Minute}}分{{end}}"},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "{{.Year}}年第{{.Quarter}}四半期"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "{{.From}}から{{.To}}まで"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Date}}{{.Weekday}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}年"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Month}}{{.Day}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "1日"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "2日"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "3日"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "4日"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "5日"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "6日"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "7日"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "8日"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "9日"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "10日"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "11日"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "12日"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "13日"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "14日"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "15日"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "16日"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "17日"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "18日"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "19日"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "20日"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "21日"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "22日"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "23日"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "24日"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "25日"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "26日"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "27日"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "28日"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "29日"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "30日"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Name}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "閏{{.Month}}"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "正月"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "二月"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "三月"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "四月"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "五月"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "六月"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "七月"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "八月"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "九月"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "十月"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "十一月"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "十二月"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "正月"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "二月"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "三月"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "四月"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "五月"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "六月"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "七月"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "八月"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "九月"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "十月"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "十一月"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "十二月"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "ティスレ"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "へシボン"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "キスレブ"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "テベット"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "シバット"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "アダル I"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "アダル"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "アダル II"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "ニサン"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "イヤル"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "シバン"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "タムズ"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "アヴ"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "エルル"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "ティスレ"},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "へシボン"},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "キスレブ"},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "テベット"},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "シバット"},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "アダル I"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "アダル"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "アダル II"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "ニサン"},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "イヤル"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "シバン"},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "タムズ"},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "アヴ"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "エルル"},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "ムハッラム"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "サフアル"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "ラビー・ウル・アウワル"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "ラビー・ウッ・サーニー"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "ジュマーダル・アウワル"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "ジュマーダッサーニー"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "ラジャブ"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "シャアバーン"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "ラマダーン"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "シャウワール"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "ズル・カイダ"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "ズル・ヒッジャ"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "ムハッラム"},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "サフアル"},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "ラビー・ウル・アウワル"},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "ラビー・ウッ・サーニー"},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "ジュマーダル・アウワル"},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "ジュマーダッサーニー"},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "ラジャブ"},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "シャアバーン"},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "ラマダーン"},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "シャウワール"},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "ズル・カイダ"},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "ズル・ヒッジャ"},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "ファルヴァルディーン"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "オルディーベヘシュト"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "ホルダード"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "ティール"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "モルダード"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "シャハリーヴァル"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "メフル"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "アーバーン"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "アーザル"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "デイ"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "バフマン"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "エスファンド"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "ファルヴァルディーン"},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "オルディーベヘシュト"},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "ホルダード"},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "ティール"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "モルダード"},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "シャハリーヴァル"},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "メフル"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "アーバーン"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "アーザル"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "デイ"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "バフマン"},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "エスファンド"},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: ", "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", Other: "{{.Count}}日"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", Other: "{{.Count}}時間"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", Other: "{{.Count}}分"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}}{{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", Other: "{{.Count}}秒"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}}、{{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}、{{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "1月"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "2月"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "3月"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "4月"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "5月"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "6月"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "7月"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "8月"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "9月"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "10月"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "11月"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "12月"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "1月"},
+		{ID: "month.short.2", Description: "Short name of February", Other: "2月"},
+		{ID: "month.short.3", Description: "Short name of March", Other: "3月"},
+		{ID: "month.short.4", Description: "Short name of April", Other: "4月"},
+		{ID: "month.short.5", Description: "Short name of May", Other: "5月"},
+		{ID: "month.short.6", Description: "Short name of June", Other: "6月"},
+		{ID: "month.short.7", Description: "Short name of July", Other: "7月"},
+		{ID: "month.short.8", Description: "Short name of August", Other: "8月"},
+		{ID: "month.short.9", Description: "Short name of September", Other: "9月"},
+		{ID: "month.short.10", Description: "Short name of October", Other: "10月"},
+		{ID: "month.short.11", Description: "Short name of November", Other: "11月"},
+		{ID: "month.short.12", Description: "Short name of December", Other: "12月"},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "毎日"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "{{.Count}}日ごと"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "末日"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "{{.Day}}日"},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "毎月"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "{{.Count}}か月ごと"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "第1"},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "第2"},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "第3"},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "第4"},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "第5"},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "最終"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "{{.Nth}}{{.Weekday}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}}{{.Days}}"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "1回"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", Other: "{{.Count}}回"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "毎週"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "毎週{{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "{{.Count}}週間ごと"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "{{.Count}}週間ごとの{{.Days}}"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "毎年"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "{{.Count}}年ごと"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", Other: "{{.Count}}日後"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", Other: "{{.Count}}時間後"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", Other: "{{.Count}}分後"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", Other: "{{.Count}}か月後"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", Other: "{{.Count}}週間後"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", Other: "{{.Count}}年後"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "今"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", Other: "{{.Count}}日前"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", Other: "{{.Count}}時間前"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", Other: "{{.Count}}分前"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", Other: "{{.Count}}か月前"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", Other: "{{.Count}}週間前"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", Other: "{{.Count}}年前"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "日曜日"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "月曜日"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "火曜日"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "水曜日"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "木曜日"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "金曜日"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "土曜日"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "日"},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "月"},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "火"},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "水"},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "木"},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "金"},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "土"},
+	},
+	"ko": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "오전 {{.Hour}}시{{if .Minute}} {{.Minute}}분{{end}}"},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{if .Year}}{{.Year}}년 {{end}}{{.Month}} {{.Day}}일"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{if .Year}}{{.Year}}년 {{end}}{{.Month}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "오후 {{.Hour}}시{{if .Minute}} {{.Minute}}분{{end}}"},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "{{.Year}}년 {{.Quarter}}분기"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "{{.From}}부터 {{.To}}까지"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Date}} {{.Weekday}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}년"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Month}} {{.Day}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "1일"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "2일"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "3일"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "4일"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "5일"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "6일"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "7일"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "8일"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "9일"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "10일"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "11일"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "12일"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "13일"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "14일"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "15일"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "16일"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "17일"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "18일"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "19일"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "20일"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "21일"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "22일"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "23일"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "24일"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "25일"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "26일"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "27일"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "28일"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "29일"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "30일"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Name}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "윤{{.Month}}"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "1월"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "2월"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "3월"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "4월"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "5월"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "6월"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "7월"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "8월"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "9월"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "10월"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "11월"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "12월"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "1월"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "2월"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "3월"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "4월"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "5월"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "6월"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "7월"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "8월"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "9월"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "10월"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "11월"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "12월"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "디스리월"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "말케스월"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "기슬르월"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "데벳월"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "스밧월"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "아달월 1"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "아달월"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "아달월 2"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "닛산월"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "이야르월"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "시완월"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "담무르월"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "압월"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "엘룰월"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "디스리월"},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "말케스월"},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "기슬르월"},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "데벳월"},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "스밧월"},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "아달월 1"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "아달월"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "아달월 2"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "닛산월"},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "이야르월"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "시완월"},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "담무르월"},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "압월"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "엘룰월"},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "무하람"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "사파르"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "라비 알 아왈"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "라비 알 쎄니"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "주마다 알 아왈"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "주마다 알 쎄니"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "라잡"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "쉐아반"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "라마단"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "쉐왈"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "듀 알 까다"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "듀 알 히자"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "무하람"},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "사파르"},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "라비 알 아왈"},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "라비 알 쎄니"},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "주마다 알 아왈"},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "주마다 알 쎄니"},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "라잡"},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "쉐아반"},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "라마단"},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "쉐왈"},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "듀 알 까다"},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "듀 알 히자"},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "화르바딘"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "오르디베헤쉬트"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "호르다드"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "티르"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "모르다드"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "샤흐리바르"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "메흐르"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "아반"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "아자르"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "다이"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "바흐만"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "에스판드"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "화르바딘"},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "오르디베헤쉬트"},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "호르다드"},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "티르"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "모르다드"},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "샤흐리바르"},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "메흐르"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "아반"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "아자르"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "다이"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "바흐만"},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "에스판드"},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: ", "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", Other: "{{.Count}}일"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", Other: "{{.Count}}시간"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", Other: "{{.Count}}분"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}} {{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", Other: "{{.Count}}초"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}} 및 {{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}, {{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "1월"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "2월"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "3월"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "4월"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "5월"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "6월"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "7월"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "8월"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "9월"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "10월"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "11월"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "12월"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "1월"},
+		{ID: "month.short.2", Description: "Short name of February", Other: "2월"},
+		{ID: "month.short.3", Description: "Short name of March", Other: "3월"},
+		{ID: "month.short.4", Description: "Short name of April", Other: "4월"},
+		{ID: "month.short.5", Description: "Short name of May", Other: "5월"},
+		{ID: "month.short.6", Description: "Short name of June", Other: "6월"},
+		{ID: "month.short.7", Description: "Short name of July", Other: "7월"},
+		{ID: "month.short.8", Description: "Short name of August", Other: "8월"},
+		{ID: "month.short.9", Description: "Short name of September", Other: "9월"},
+		{ID: "month.short.10", Description: "Short name of October", Other: "10월"},
+		{ID: "month.short.11", Description: "Short name of November", Other: "11월"},
+		{ID: "month.short.12", Description: "Short name of December", Other: "12월"},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "매일"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "{{.Count}}일마다"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "마지막 날"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "{{.Day}}일"},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "매월"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "{{.Count}}개월마다"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "첫째"},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "둘째"},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "셋째"},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "넷째"},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "다섯째"},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "마지막"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "{{.Nth}} {{.Weekday}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}} {{.Days}}"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "1회"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", Other: "{{.Count}}회"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "매주"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "매주 {{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "{{.Count}}주마다"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "{{.Count}}주마다 {{.Days}}"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "매년"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "{{.Count}}년마다"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", Other: "{{.Count}}일 후"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", Other: "{{.Count}}시간 후"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", Other: "{{.Count}}분 후"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", Other: "{{.Count}}개월 후"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", Other: "{{.Count}}주 후"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", Other: "{{.Count}}년 후"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "지금"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", Other: "{{.Count}}일 전"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", Other: "{{.Count}}시간 전"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", Other: "{{.Count}}분 전"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", Other: "{{.Count}}개월 전"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", Other: "{{.Count}}주 전"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", Other: "{{.Count}}년 전"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "일요일"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "월요일"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "화요일"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "수요일"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "목요일"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "금요일"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "토요일"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "일"},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "월"},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "화"},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "수"},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "목"},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "금"},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "토"},
+	},
+	"th": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "{{.Time}} ก่อนเที่ยง"},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{.Day}} {{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "{{.Time}} หลังเที่ยง"},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "ไตรมาส {{.Quarter}} ปี {{.Year}}"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "{{.From}} ถึง {{.To}}"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Weekday}}ที่ {{.Date}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Month}} {{.Day}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "1"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "2"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "3"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "4"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "5"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "6"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "7"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "8"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "9"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "10"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "11"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "12"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "13"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "14"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "15"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "16"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "17"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "18"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "19"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "20"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "21"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "22"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "23"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "24"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "25"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "26"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "27"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "28"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "29"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "30"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Day}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "{{.Month}} (อธิกมาส)"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนอ้าย"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนยี่"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนสาม"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนสี่"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนห้า"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนหก"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนเจ็ด"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนแปด"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนเก้า"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนสิบ"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนสิบเอ็ด"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนสิบสอง"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนอ้าย"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนยี่"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนสาม"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนสี่"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนห้า"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนหก"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนเจ็ด"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนแปด"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนเก้า"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนสิบ"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนสิบเอ็ด"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "เดือนสิบสอง"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "ทิชรี"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "เฮวาน"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "กีสเลฟ"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "เตเวต"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "เชวัต"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "อาดาร์ I"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "อาดาร์"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "อาดาร์ II"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "นิสซาน"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "อิยาร์"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "สีวัน"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "ตามูซ"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "อัฟ"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "เอลอุล"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "ทิชรี"},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "เฮวาน"},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "กีสเลฟ"},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "เตเวต"},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "เชวัต"},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "อาดาร์ I"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "อาดาร์"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "อาดาร์ II"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "นิสซาน"},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "อิยาร์"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "สีวัน"},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "ตามูซ"},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "อัฟ"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "เอลอุล"},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "มุฮะร์รอม"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "ซอฟาร์"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "รอบี I"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "รอบี II"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "จุมาดา I"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "จุมาดา II"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "รอจับ"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "ชะอะบาน"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "รอมะดอน"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "เชาวัล"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "ซุลกิอฺดะฮฺ"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "ซุลหิจญะฮฺ"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "มุฮัร."},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "เศาะ."},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "รอบี I"},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "รอบี II"},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "จุมาดา I"},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "จุมาดา II"},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "เราะ."},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "ชะอ์."},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "เราะมะ."},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "เชาว."},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "ซุลกิอฺ."},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "ซุลหิจ."},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "ฟาร์วาร์ดิน"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "ออร์ดิเบเฮชต์"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "คอร์แดด"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "เตอร์"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "มอร์แดด"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "ชาหริวาร์"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "เมฮร์"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "อะบาน"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "อะซาร์"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "เดย์"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "บาฮ์มาน"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "เอสฟานด์"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "ฟาร์วาร์ดิน"},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "ออร์ดิเบเฮชต์"},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "คอร์แดด"},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "เตอร์"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "มอร์แดด"},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "ชาหริวาร์"},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "เมฮร์"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "อะบาน"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "อะซาร์"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "เดย์"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "บาฮ์มาน"},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "เอสฟานด์"},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: ", "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", Other: "{{.Count}} วัน"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", Other: "{{.Count}} ชั่วโมง"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", Other: "{{.Count}} นาที"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}} {{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", Other: "{{.Count}} วินาที"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}} และ {{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}, {{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "มกราคม"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "กุมภาพันธ์"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "มีนาคม"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "เมษายน"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "พฤษภาคม"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "มิถุนายน"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "กรกฎาคม"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "สิงหาคม"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "กันยายน"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "ตุลาคม"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "พฤศจิกายน"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "ธันวาคม"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "ม.ค."},
+		{ID: "month.short.2", Description: "Short name of February", Other: "ก.พ."},
+		{ID: "month.short.3", Description: "Short name of March", Other: "มี.ค."},
+		{ID: "month.short.4", Description: "Short name of April", Other: "เม.ย."},
+		{ID: "month.short.5", Description: "Short name of May", Other: "พ.ค."},
+		{ID: "month.short.6", Description: "Short name of June", Other: "มิ.ย."},
+		{ID: "month.short.7", Description: "Short name of July", Other: "ก.ค."},
+		{ID: "month.short.8", Description: "Short name of August", Other: "ส.ค."},
+		{ID: "month.short.9", Description: "Short name of September", Other: "ก.ย."},
+		{ID: "month.short.10", Description: "Short name of October", Other: "ต.ค."},
+		{ID: "month.short.11", Description: "Short name of November", Other: "พ.ย."},
+		{ID: "month.short.12", Description: "Short name of December", Other: "ธ.ค."},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "ทุกวัน"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "ทุก {{.Count}} วัน"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "วันสุดท้ายของเดือน"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "วันที่ {{.Day}}"},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "ทุกเดือน"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "ทุก {{.Count}} เดือน"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "แรก"},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "ที่สอง"},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "ที่สาม"},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "ที่สี่"},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "ที่ห้า"},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "สุดท้าย"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "{{.Weekday}}{{.Nth}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}} ใน{{.Days}}"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "1 ครั้ง"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", Other: "{{.Count}} ครั้ง"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "ทุกสัปดาห์"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "ทุก{{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "ทุก {{.Count}} สัปดาห์"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "ทุก {{.Count}} สัปดาห์ใน{{.Days}}"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "ทุกปี"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "ทุก {{.Count}} ปี"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", Other: "ในอีก {{.Count}} วัน"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", Other: "ในอีก {{.Count}} ชั่วโมง"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", Other: "ในอีก {{.Count}} นาที"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", Other: "ในอีก {{.Count}} เดือน"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", Other: "ในอีก {{.Count}} สัปดาห์"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", Other: "ในอีก {{.Count}} ปี"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "ขณะนี้"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", Other: "{{.Count}} วันที่ผ่านมา"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", Other: "{{.Count}} ชั่วโมงที่ผ่านมา"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", Other: "{{.Count}} นาทีที่ผ่านมา"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", Other: "{{.Count}} เดือนที่ผ่านมา"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", Other: "{{.Count}} สัปดาห์ที่ผ่านมา"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", Other: "{{.Count}} ปีที่แล้ว"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "วันอาทิตย์"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "วันจันทร์"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "วันอังคาร"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "วันพุธ"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "วันพฤหัสบดี"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "วันศุกร์"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "วันเสาร์"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "อา."},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "จ."},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "อ."},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "พ."},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "พฤ."},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "ศ."},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "ส."},
+	},
+	"ur": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "{{.Time}} AM"},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{.Day}} {{.Month}}{{if .Year}}، {{.Year}}{{end}}"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{.Month}}{{if .Year}} {{.Year}}{{end}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "{{.Time}} PM"},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "سہ ماہی {{.Quarter}}، {{.Year}}"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "{{.From}} سے {{.To}} تک"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Weekday}}، {{.Date}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Month}} {{.Day}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "1"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "2"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "3"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "4"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "5"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "6"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "7"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "8"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "9"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "10"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "11"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "12"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "13"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "14"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "15"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "16"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "17"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "18"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "19"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "20"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "21"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "22"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "23"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "24"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "25"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "26"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "27"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "28"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "29"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "30"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Day}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "{{.Month}} لیپ"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "M01"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "M02"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "M03"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "M04"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "M05"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "M06"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M07"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "M08"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "M09"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "M10"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M11"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "M12"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "M01"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "M02"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "M03"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "M04"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "M05"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "M06"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M07"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "M08"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "M09"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "M10"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "M11"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "M12"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "تشری"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "حشوان"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "کسلیو"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "طیبت"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "شباط"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "آدر اوّل"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "آدر"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "آدر دوّم"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "نیسان"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "ایار"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "سیوان"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "تموز"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "آب"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "ایلول"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "تشری"},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "حشوان"},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "کسلیو"},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "طیبت"},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "شباط"},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "آدر اوّل"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "آدر"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "آدر دوّم"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "نیسان"},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "ایار"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "سیوان"},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "تموز"},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "آب"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "ایلول"},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "محرم"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "صفر"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "ربیع الاول"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "ربیع الثانی"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "جمادی الاول"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "جمادی الثانی"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "رجب"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "شعبان"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "رمضان"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "شوال"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "ذوالقعدہ"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "ذوالحجہ"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "محرم"},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "صفر"},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "ربیع الاول"},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "ربیع الثانی"},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "جمادی الاول"},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "جمادی الثانی"},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "رجب"},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "شعبان"},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "رمضان"},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "شوال"},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "ذوالقعدہ"},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "ذوالحجہ"},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "فروردین"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "اردیبہشت"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "خرداد"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "تیر"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "مرداد"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "شہریور"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "مہر"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "آبان"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "آذر"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "دی"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "بہمن"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "اسفند"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "فروردین"},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "اردیبہشت"},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "خرداد"},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "تیر"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "مرداد"},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "شہریور"},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "مہر"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "آبان"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "آذر"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "دی"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "بہمن"},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "اسفند"},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: "، "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", One: "{{.Count}} دن", Other: "{{.Count}} دن"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", One: "{{.Count}} گھنٹہ", Other: "{{.Count}} گھنٹے"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", One: "{{.Count}} منٹ", Other: "{{.Count}} منٹ"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}} {{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", One: "{{.Count}} سیکنڈ", Other: "{{.Count}} سیکنڈ"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}} اور {{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}، {{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "جنوری"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "فروری"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "مارچ"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "اپریل"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "مئی"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "جون"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "جولائی"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "اگست"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "ستمبر"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "اکتوبر"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "نومبر"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "دسمبر"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "جنوری"},
+		{ID: "month.short.2", Description: "Short name of February", Other: "فروری"},
+		{ID: "month.short.3", Description: "Short name of March", Other: "مارچ"},
+		{ID: "month.short.4", Description: "Short name of April", Other: "اپریل"},
+		{ID: "month.short.5", Description: "Short name of May", Other: "مئی"},
+		{ID: "month.short.6", Description: "Short name of June", Other: "جون"},
+		{ID: "month.short.7", Description: "Short name of July", Other: "جولائی"},
+		{ID: "month.short.8", Description: "Short name of August", Other: "اگست"},
+		{ID: "month.short.9", Description: "Short name of September", Other: "ستمبر"},
+		{ID: "month.short.10", Description: "Short name of October", Other: "اکتوبر"},
+		{ID: "month.short.11", Description: "Short name of November", Other: "نومبر"},
+		{ID: "month.short.12", Description: "Short name of December", Other: "دسمبر"},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "روزانہ"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "ہر {{.Count}} دن"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "آخری دن"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "دن {{.Day}}"},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "ماہانہ"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "ہر {{.Count}} مہینے"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "پہلا"},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "دوسرا"},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "تیسرا"},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "چوتھا"},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "پانچواں"},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "آخری"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "{{.Nth}} {{.Weekday}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}} {{.Days}} کو"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "ایک بار"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", Other: "{{.Count}} بار"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "ہفتہ وار"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "ہر {{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "ہر {{.Count}} ہفتے"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "ہر {{.Count}} ہفتے {{.Days}} کو"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "سالانہ"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "ہر {{.Count}} سال"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", One: "{{.Count}} دن میں", Other: "{{.Count}} دن میں"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", One: "{{.Count}} گھنٹہ میں", Other: "{{.Count}} گھنٹے میں"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", One: "{{.Count}} منٹ میں", Other: "{{.Count}} منٹ میں"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", One: "{{.Count}} مہینہ میں", Other: "{{.Count}} مہینے میں"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", One: "{{.Count}} ہفتہ میں", Other: "{{.Count}} ہفتے میں"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", One: "{{.Count}} سال میں", Other: "{{.Count}} سال میں"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "ابھی"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", One: "{{.Count}} دن پہلے", Other: "{{.Count}} دن پہلے"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", One: "{{.Count}} گھنٹہ پہلے", Other: "{{.Count}} گھنٹے پہلے"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", One: "{{.Count}} منٹ پہلے", Other: "{{.Count}} منٹ پہلے"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", One: "{{.Count}} مہینہ پہلے", Other: "{{.Count}} مہینے پہلے"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", One: "{{.Count}} ہفتہ پہلے", Other: "{{.Count}} ہفتے پہلے"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", One: "{{.Count}} سال پہلے", Other: "{{.Count}} سال پہلے"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "اتوار"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "پیر"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "منگل"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "بدھ"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "جمعرات"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "جمعہ"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "ہفتہ"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "اتوار"},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "پیر"},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "منگل"},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "بدھ"},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "جمعرات"},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "جمعہ"},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "ہفتہ"},
+	},
+	"vi": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "{{.Time}} SA"},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{.Day}} {{.Month}}{{if .Year}}, {{.Year}}{{end}}"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{.Month}}{{if .Year}} năm {{.Year}}{{end}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "{{.Time}} CH"},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "Quý {{.Quarter}} năm {{.Year}}"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "{{.From}} đến {{.To}}"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Weekday}}, {{.Date}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Day}} {{.Month}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "Mùng 1"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "Mùng 2"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "Mùng 3"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "Mùng 4"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "Mùng 5"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "Mùng 6"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "Mùng 7"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "Mùng 8"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "Mùng 9"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "Mùng 10"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "11"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "12"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "13"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "14"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "15"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "16"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "17"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "18"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "19"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "20"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "21"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "22"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "23"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "24"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "25"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "26"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "27"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "28"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "29"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "30"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Day}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "{{.Month}} nhuận"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng Giêng"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng Hai"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng Ba"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng Tư"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng Năm"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng Sáu"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng Bảy"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng Tám"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng Chín"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng Mười"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng Mười Một"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng Chạp"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "Tết"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng 2"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng 3"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng 4"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng 5"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng 6"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng 7"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng 8"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng 9"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng 10"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng 11"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "tháng Chạp"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "Tishri"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "Heshvan"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "Kislev"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "Tevet"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "Shevat"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "Adar I"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "Adar"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "Adar II"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "Nisan"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "Iyar"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "Sivan"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "Tamuz"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "Av"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "Elul"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "Tishri"},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "Heshvan"},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "Kislev"},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "Tevet"},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "Shevat"},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "Adar I"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "Adar"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "Adar II"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "Nisan"},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "Iyar"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "Sivan"},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "Tamuz"},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "Av"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "Elul"},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "Muharram"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "Safar"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "Rabiʻ I"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "Rabiʻ II"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "Jumada I"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "Jumada II"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "Rajab"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "Shaʻban"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "Ramadan"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "Shawwal"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "Dhuʻl-Qiʻdah"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "Dhuʻl-Hijjah"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "Muh."},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "Saf."},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "Rab. I"},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "Rab. II"},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "Jum. I"},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "Jum. II"},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "Raj."},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "Sha."},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "Ram."},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "Shaw."},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "Dhuʻl-Q."},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "Dhuʻl-H."},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "Farvardin"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "Ordibehesht"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "Khordad"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "Tir"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "Mordad"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "Shahrivar"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "Mehr"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "Aban"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "Azar"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "Dey"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "Bahman"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "Esfand"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "Farvardin"},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "Ordibehesht"},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "Khordad"},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "Tir"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "Mordad"},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "Shahrivar"},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "Mehr"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "Aban"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "Azar"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "Dey"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "Bahman"},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "Esfand"},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: ", "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", Other: "{{.Count}} ngày"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", Other: "{{.Count}} giờ"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", Other: "{{.Count}} phút"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}} {{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", Other: "{{.Count}} giây"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}} và {{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}, {{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "Tháng Một"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "Tháng Hai"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "Tháng Ba"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "Tháng Tư"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "Tháng Năm"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "Tháng Sáu"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "Tháng Bảy"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "Tháng Tám"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "Tháng Chín"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "Tháng Mười"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "Tháng Mười Một"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "Tháng Mười Hai"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "Th1"},
+		{ID: "month.short.2", Description: "Short name of February", Other: "Th2"},
+		{ID: "month.short.3", Description: "Short name of March", Other: "Th3"},
+		{ID: "month.short.4", Description: "Short name of April", Other: "Th4"},
+		{ID: "month.short.5", Description: "Short name of May", Other: "Th5"},
+		{ID: "month.short.6", Description: "Short name of June", Other: "Th6"},
+		{ID: "month.short.7", Description: "Short name of July", Other: "Th7"},
+		{ID: "month.short.8", Description: "Short name of August", Other: "Th8"},
+		{ID: "month.short.9", Description: "Short name of September", Other: "Th9"},
+		{ID: "month.short.10", Description: "Short name of October", Other: "Th10"},
+		{ID: "month.short.11", Description: "Short name of November", Other: "Th11"},
+		{ID: "month.short.12", Description: "Short name of December", Other: "Th12"},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "Hằng ngày"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "Mỗi {{.Count}} ngày"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "ngày cuối cùng"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "ngày {{.Day}}"},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "Hằng tháng"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "Mỗi {{.Count}} tháng"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "đầu tiên"},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "thứ 2"},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "thứ 3"},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "thứ 4"},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "thứ 5"},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "cuối cùng"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "{{.Weekday}} {{.Nth}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}} vào {{.Days}}"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "1 lần"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", Other: "{{.Count}} lần"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "Hằng tuần"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "Mỗi {{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "Mỗi {{.Count}} tuần"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "Mỗi {{.Count}} tuần vào {{.Days}}"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "Hằng năm"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "Mỗi {{.Count}} năm"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", Other: "sau {{.Count}} ngày"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", Other: "sau {{.Count}} giờ"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", Other: "sau {{.Count}} phút"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", Other: "sau {{.Count}} tháng"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", Other: "sau {{.Count}} tuần"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", Other: "sau {{.Count}} năm"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "bây giờ"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", Other: "{{.Count}} ngày trước"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", Other: "{{.Count}} giờ trước"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", Other: "{{.Count}} phút trước"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", Other: "{{.Count}} tháng trước"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", Other: "{{.Count}} tuần trước"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", Other: "{{.Count}} năm trước"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "Chủ Nhật"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "Thứ Hai"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "Thứ Ba"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "Thứ Tư"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "Thứ Năm"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "Thứ Sáu"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "Thứ Bảy"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "CN"},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "T2"},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "T3"},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "T4"},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "T5"},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "T6"},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "T7"},
+	},
+	"zh-CN": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "上午{{.Hour}}点{{if .Minute}}{{.Minute}}分{{end}}"},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{if .Year}}{{.Year}}年{{end}}{{.Month}}{{.Day}}日"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{if .Year}}{{.Year}}年{{end}}{{.Month}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "下午{{.Hour}}点{{if .Minute}}{{.Minute}}分{{end}}"},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "{{.Year}}年第{{.Quarter}}季度"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "{{.From}}至{{.To}}"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Date}}{{.Weekday}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}年"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Month}}{{.Day}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初一"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初二"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初三"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初四"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初五"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初六"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初七"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初八"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初九"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初十"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十一"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十二"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十三"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十四"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十五"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十六"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十七"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十八"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十九"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "二十"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿一"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿二"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿三"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿四"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿五"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿六"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿七"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿八"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿九"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "三十"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Name}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "闰{{.Month}}"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "正月"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "二月"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "三月"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "四月"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "五月"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "六月"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "七月"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "八月"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "九月"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "十月"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "冬月"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "腊月"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "正月"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "二月"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "三月"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "四月"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "五月"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "六月"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "七月"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "八月"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "九月"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "十月"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "冬月"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "腊月"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "提斯利月"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "玛西班月"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "基斯流月"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "提别月"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "细罢特月"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "亚达月 I"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "亚达月"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "亚达月 II"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "尼散月"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "以珥月"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "西弯月"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "搭模斯月"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "埃波月"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "以禄月"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "提斯利月"},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "玛西班月"},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "基斯流月"},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "提别月"},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "细罢特月"},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "亚达月 I"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "亚达月"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "亚达月 II"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "尼散月"},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "以珥月"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "西弯月"},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "搭模斯月"},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "埃波月"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "以禄月"},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "一月"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "二月"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "三月"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "四月"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "五月"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "六月"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "七月"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "八月"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "九月"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "十月"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "十一月"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "十二月"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "1月"},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "2月"},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "3月"},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "4月"},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "5月"},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "6月"},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "7月"},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "8月"},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "9月"},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "10月"},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "11月"},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "12月"},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "一月"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "二月"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "三月"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "四月"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "五月"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "六月"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "七月"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "八月"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "九月"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "十月"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "十一月"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "十二月"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "1月"},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "2月"},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "3月"},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "4月"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "5月"},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "6月"},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "7月"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "8月"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "9月"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "10月"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "11月"},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "12月"},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: ", "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", Other: "{{.Count}}天"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", Other: "{{.Count}}小时"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", Other: "{{.Count}}分钟"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}}{{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", Other: "{{.Count}}秒"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}}和{{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}、{{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "一月"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "二月"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "三月"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "四月"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "五月"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "六月"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "七月"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "八月"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "九月"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "十月"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "十一月"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "十二月"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "1月"},
+		{ID: "month.short.2", Description: "Short name of February", Other: "2月"},
+		{ID: "month.short.3", Description: "Short name of March", Other: "3月"},
+		{ID: "month.short.4", Description: "Short name of April", Other: "4月"},
+		{ID: "month.short.5", Description: "Short name of May", Other: "5月"},
+		{ID: "month.short.6", Description: "Short name of June", Other: "6月"},
+		{ID: "month.short.7", Description: "Short name of July", Other: "7月"},
+		{ID: "month.short.8", Description: "Short name of August", Other: "8月"},
+		{ID: "month.short.9", Description: "Short name of September", Other: "9月"},
+		{ID: "month.short.10", Description: "Short name of October", Other: "10月"},
+		{ID: "month.short.11", Description: "Short name of November", Other: "11月"},
+		{ID: "month.short.12", Description: "Short name of December", Other: "12月"},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "每天"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "每{{.Count}}天"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "最后一天"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "{{.Day}}日"},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "每月"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "每{{.Count}}个月"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "第1个"},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "第2个"},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "第3个"},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "第4个"},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "第5个"},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "最后一个"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "{{.Nth}}{{.Weekday}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}}{{.Days}}"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "1次"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", Other: "{{.Count}}次"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "每周"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "每{{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "每{{.Count}}周"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "每{{.Count}}周的{{.Days}}"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "每年"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "每{{.Count}}年"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", Other: "{{.Count}}天后"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", Other: "{{.Count}}小时后"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", Other: "{{.Count}}分钟后"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", Other: "{{.Count}}个月后"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", Other: "{{.Count}}周后"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", Other: "{{.Count}}年后"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "现在"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", Other: "{{.Count}}天前"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", Other: "{{.Count}}小时前"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", Other: "{{.Count}}分钟前"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", Other: "{{.Count}}个月前"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", Other: "{{.Count}}周前"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", Other: "{{.Count}}年前"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "星期日"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "星期一"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "星期二"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "星期三"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "星期四"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "星期五"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "星期六"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "日"},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "一"},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "二"},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "三"},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "四"},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "五"},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "六"},
+	},
+	"zh-TW": {
+		{ID: "accessible.am", Description: "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM", Other: "上午{{.Hour}}點{{if .Minute}}{{.Minute}}分{{end}}"},
+		{ID: "accessible.date", Description: "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023", Other: "{{if .Year}}{{.Year}}年{{end}}{{.Month}}{{.Day}}日"},
+		{ID: "accessible.month", Description: "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023", Other: "{{if .Year}}{{.Year}}年{{end}}{{.Month}}"},
+		{ID: "accessible.pm", Description: "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM", Other: "下午{{.Hour}}點{{if .Minute}}{{.Minute}}分{{end}}"},
+		{ID: "accessible.quarter", Description: "A quarter read out in full by screen readers, e.g. Quarter 1, 2023", Other: "{{.Year}}年第{{.Quarter}}季"},
+		{ID: "accessible.range", Description: "A range read out in full by screen readers, e.g. January 1 to January 12, 2023", Other: "{{.From}}至{{.To}}"},
+		{ID: "accessible.weekday", Description: "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023", Other: "{{.Date}}{{.Weekday}}"},
+		{ID: "accessible.year", Description: "A year read out in full by screen readers, e.g. 2023", Other: "{{.Year}}年"},
+		{ID: "calendar.chinese.date", Description: "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"", Other: "{{.Month}}{{.Day}}"},
+		{ID: "calendar.chinese.day.1", Description: "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初一"},
+		{ID: "calendar.chinese.day.2", Description: "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初二"},
+		{ID: "calendar.chinese.day.3", Description: "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初三"},
+		{ID: "calendar.chinese.day.4", Description: "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初四"},
+		{ID: "calendar.chinese.day.5", Description: "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初五"},
+		{ID: "calendar.chinese.day.6", Description: "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初六"},
+		{ID: "calendar.chinese.day.7", Description: "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初七"},
+		{ID: "calendar.chinese.day.8", Description: "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初八"},
+		{ID: "calendar.chinese.day.9", Description: "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初九"},
+		{ID: "calendar.chinese.day.10", Description: "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "初十"},
+		{ID: "calendar.chinese.day.11", Description: "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十一"},
+		{ID: "calendar.chinese.day.12", Description: "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十二"},
+		{ID: "calendar.chinese.day.13", Description: "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十三"},
+		{ID: "calendar.chinese.day.14", Description: "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十四"},
+		{ID: "calendar.chinese.day.15", Description: "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十五"},
+		{ID: "calendar.chinese.day.16", Description: "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十六"},
+		{ID: "calendar.chinese.day.17", Description: "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十七"},
+		{ID: "calendar.chinese.day.18", Description: "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十八"},
+		{ID: "calendar.chinese.day.19", Description: "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "十九"},
+		{ID: "calendar.chinese.day.20", Description: "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "二十"},
+		{ID: "calendar.chinese.day.21", Description: "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿一"},
+		{ID: "calendar.chinese.day.22", Description: "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿二"},
+		{ID: "calendar.chinese.day.23", Description: "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿三"},
+		{ID: "calendar.chinese.day.24", Description: "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿四"},
+		{ID: "calendar.chinese.day.25", Description: "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿五"},
+		{ID: "calendar.chinese.day.26", Description: "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿六"},
+		{ID: "calendar.chinese.day.27", Description: "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿七"},
+		{ID: "calendar.chinese.day.28", Description: "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿八"},
+		{ID: "calendar.chinese.day.29", Description: "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "廿九"},
+		{ID: "calendar.chinese.day.30", Description: "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars", Other: "三十"},
+		{ID: "calendar.chinese.day.end", Description: "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"", Other: "{{.Name}}"},
+		{ID: "calendar.chinese.month.leap", Description: "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats", Other: "閏{{.Month}}"},
+		{ID: "calendar.chinese.month.long.1", Description: "Full name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "正月"},
+		{ID: "calendar.chinese.month.long.2", Description: "Full name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "二月"},
+		{ID: "calendar.chinese.month.long.3", Description: "Full name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "三月"},
+		{ID: "calendar.chinese.month.long.4", Description: "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "四月"},
+		{ID: "calendar.chinese.month.long.5", Description: "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "五月"},
+		{ID: "calendar.chinese.month.long.6", Description: "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "六月"},
+		{ID: "calendar.chinese.month.long.7", Description: "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "七月"},
+		{ID: "calendar.chinese.month.long.8", Description: "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "八月"},
+		{ID: "calendar.chinese.month.long.9", Description: "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "九月"},
+		{ID: "calendar.chinese.month.long.10", Description: "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "十月"},
+		{ID: "calendar.chinese.month.long.11", Description: "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "冬月"},
+		{ID: "calendar.chinese.month.long.12", Description: "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "臘月"},
+		{ID: "calendar.chinese.month.short.1", Description: "Short name of the first month of the Chinese and Vietnamese lunisolar calendars", Other: "正月"},
+		{ID: "calendar.chinese.month.short.2", Description: "Short name of the second month of the Chinese and Vietnamese lunisolar calendars", Other: "二月"},
+		{ID: "calendar.chinese.month.short.3", Description: "Short name of the third month of the Chinese and Vietnamese lunisolar calendars", Other: "三月"},
+		{ID: "calendar.chinese.month.short.4", Description: "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars", Other: "四月"},
+		{ID: "calendar.chinese.month.short.5", Description: "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars", Other: "五月"},
+		{ID: "calendar.chinese.month.short.6", Description: "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars", Other: "六月"},
+		{ID: "calendar.chinese.month.short.7", Description: "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars", Other: "七月"},
+		{ID: "calendar.chinese.month.short.8", Description: "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars", Other: "八月"},
+		{ID: "calendar.chinese.month.short.9", Description: "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars", Other: "九月"},
+		{ID: "calendar.chinese.month.short.10", Description: "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars", Other: "十月"},
+		{ID: "calendar.chinese.month.short.11", Description: "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars", Other: "冬月"},
+		{ID: "calendar.chinese.month.short.12", Description: "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars", Other: "臘月"},
+		{ID: "calendar.hebrew.month.long.1", Description: "Full name of the Hebrew month of Tishrei", Other: "提斯利月"},
+		{ID: "calendar.hebrew.month.long.2", Description: "Full name of the Hebrew month of Heshvan", Other: "瑪西班月"},
+		{ID: "calendar.hebrew.month.long.3", Description: "Full name of the Hebrew month of Kislev", Other: "基斯流月"},
+		{ID: "calendar.hebrew.month.long.4", Description: "Full name of the Hebrew month of Tevet", Other: "提別月"},
+		{ID: "calendar.hebrew.month.long.5", Description: "Full name of the Hebrew month of Shevat", Other: "細罷特月"},
+		{ID: "calendar.hebrew.month.long.6", Description: "Full name of the Hebrew month of Adar I, in leap years", Other: "亞達月 I"},
+		{ID: "calendar.hebrew.month.long.7", Description: "Full name of the Hebrew month of Adar, in common years", Other: "亞達月"},
+		{ID: "calendar.hebrew.month.long.7.leap", Description: "Full name of the Hebrew month of Adar II, in leap years", Other: "亞達月 II"},
+		{ID: "calendar.hebrew.month.long.8", Description: "Full name of the Hebrew month of Nisan", Other: "尼散月"},
+		{ID: "calendar.hebrew.month.long.9", Description: "Full name of the Hebrew month of Iyar", Other: "以珥月"},
+		{ID: "calendar.hebrew.month.long.10", Description: "Full name of the Hebrew month of Sivan", Other: "西彎月"},
+		{ID: "calendar.hebrew.month.long.11", Description: "Full name of the Hebrew month of Tamuz", Other: "搭模斯月"},
+		{ID: "calendar.hebrew.month.long.12", Description: "Full name of the Hebrew month of Av", Other: "埃波月"},
+		{ID: "calendar.hebrew.month.long.13", Description: "Full name of the Hebrew month of Elul", Other: "以祿月"},
+		{ID: "calendar.hebrew.month.short.1", Description: "Short name of the Hebrew month of Tishrei", Other: "提斯利月"},
+		{ID: "calendar.hebrew.month.short.2", Description: "Short name of the Hebrew month of Heshvan", Other: "瑪西班月"},
+		{ID: "calendar.hebrew.month.short.3", Description: "Short name of the Hebrew month of Kislev", Other: "基斯流月"},
+		{ID: "calendar.hebrew.month.short.4", Description: "Short name of the Hebrew month of Tevet", Other: "提別月"},
+		{ID: "calendar.hebrew.month.short.5", Description: "Short name of the Hebrew month of Shevat", Other: "細罷特月"},
+		{ID: "calendar.hebrew.month.short.6", Description: "Short name of the Hebrew month of Adar I, in leap years", Other: "亞達月 I"},
+		{ID: "calendar.hebrew.month.short.7", Description: "Short name of the Hebrew month of Adar, in common years", Other: "亞達月"},
+		{ID: "calendar.hebrew.month.short.7.leap", Description: "Short name of the Hebrew month of Adar II, in leap years", Other: "亞達月 II"},
+		{ID: "calendar.hebrew.month.short.8", Description: "Short name of the Hebrew month of Nisan", Other: "尼散月"},
+		{ID: "calendar.hebrew.month.short.9", Description: "Short name of the Hebrew month of Iyar", Other: "以珥月"},
+		{ID: "calendar.hebrew.month.short.10", Description: "Short name of the Hebrew month of Sivan", Other: "西彎月"},
+		{ID: "calendar.hebrew.month.short.11", Description: "Short name of the Hebrew month of Tamuz", Other: "搭模斯月"},
+		{ID: "calendar.hebrew.month.short.12", Description: "Short name of the Hebrew month of Av", Other: "埃波月"},
+		{ID: "calendar.hebrew.month.short.13", Description: "Short name of the Hebrew month of Elul", Other: "以祿月"},
+		{ID: "calendar.islamic.month.long.1", Description: "Full name of the Islamic month of Muharram", Other: "穆哈蘭姆月"},
+		{ID: "calendar.islamic.month.long.2", Description: "Full name of the Islamic month of Safar", Other: "色法爾月"},
+		{ID: "calendar.islamic.month.long.3", Description: "Full name of the Islamic month of Rabiʻ I", Other: "賴比月 I"},
+		{ID: "calendar.islamic.month.long.4", Description: "Full name of the Islamic month of Rabiʻ II", Other: "賴比月 II"},
+		{ID: "calendar.islamic.month.long.5", Description: "Full name of the Islamic month of Jumada I", Other: "主馬達月 I"},
+		{ID: "calendar.islamic.month.long.6", Description: "Full name of the Islamic month of Jumada II", Other: "主馬達月 II"},
+		{ID: "calendar.islamic.month.long.7", Description: "Full name of the Islamic month of Rajab", Other: "賴哲卜月"},
+		{ID: "calendar.islamic.month.long.8", Description: "Full name of the Islamic month of Shaʻban", Other: "舍爾邦月"},
+		{ID: "calendar.islamic.month.long.9", Description: "Full name of the Islamic month of Ramadan", Other: "賴買丹月"},
+		{ID: "calendar.islamic.month.long.10", Description: "Full name of the Islamic month of Shawwal", Other: "閃瓦魯月"},
+		{ID: "calendar.islamic.month.long.11", Description: "Full name of the Islamic month of Dhuʻl-Qiʻdah", Other: "都爾喀爾德月"},
+		{ID: "calendar.islamic.month.long.12", Description: "Full name of the Islamic month of Dhuʻl-Hijjah", Other: "都爾黑哲月"},
+		{ID: "calendar.islamic.month.short.1", Description: "Short name of the Islamic month of Muharram", Other: "穆哈蘭姆月"},
+		{ID: "calendar.islamic.month.short.2", Description: "Short name of the Islamic month of Safar", Other: "色法爾月"},
+		{ID: "calendar.islamic.month.short.3", Description: "Short name of the Islamic month of Rabiʻ I", Other: "賴比月 I"},
+		{ID: "calendar.islamic.month.short.4", Description: "Short name of the Islamic month of Rabiʻ II", Other: "賴比月 II"},
+		{ID: "calendar.islamic.month.short.5", Description: "Short name of the Islamic month of Jumada I", Other: "主馬達月 I"},
+		{ID: "calendar.islamic.month.short.6", Description: "Short name of the Islamic month of Jumada II", Other: "主馬達月 II"},
+		{ID: "calendar.islamic.month.short.7", Description: "Short name of the Islamic month of Rajab", Other: "賴哲卜月"},
+		{ID: "calendar.islamic.month.short.8", Description: "Short name of the Islamic month of Shaʻban", Other: "舍爾邦月"},
+		{ID: "calendar.islamic.month.short.9", Description: "Short name of the Islamic month of Ramadan", Other: "賴買丹月"},
+		{ID: "calendar.islamic.month.short.10", Description: "Short name of the Islamic month of Shawwal", Other: "閃瓦魯月"},
+		{ID: "calendar.islamic.month.short.11", Description: "Short name of the Islamic month of Dhuʻl-Qiʻdah", Other: "都爾喀爾德月"},
+		{ID: "calendar.islamic.month.short.12", Description: "Short name of the Islamic month of Dhuʻl-Hijjah", Other: "都爾黑哲月"},
+		{ID: "calendar.persian.month.long.1", Description: "Full name of the Persian month of Farvardin", Other: "1月"},
+		{ID: "calendar.persian.month.long.2", Description: "Full name of the Persian month of Ordibehesht", Other: "2月"},
+		{ID: "calendar.persian.month.long.3", Description: "Full name of the Persian month of Khordad", Other: "3月"},
+		{ID: "calendar.persian.month.long.4", Description: "Full name of the Persian month of Tir", Other: "4月"},
+		{ID: "calendar.persian.month.long.5", Description: "Full name of the Persian month of Mordad", Other: "5月"},
+		{ID: "calendar.persian.month.long.6", Description: "Full name of the Persian month of Shahrivar", Other: "6月"},
+		{ID: "calendar.persian.month.long.7", Description: "Full name of the Persian month of Mehr", Other: "7月"},
+		{ID: "calendar.persian.month.long.8", Description: "Full name of the Persian month of Aban", Other: "8月"},
+		{ID: "calendar.persian.month.long.9", Description: "Full name of the Persian month of Azar", Other: "9月"},
+		{ID: "calendar.persian.month.long.10", Description: "Full name of the Persian month of Dey", Other: "10月"},
+		{ID: "calendar.persian.month.long.11", Description: "Full name of the Persian month of Bahman", Other: "11月"},
+		{ID: "calendar.persian.month.long.12", Description: "Full name of the Persian month of Esfand", Other: "12月"},
+		{ID: "calendar.persian.month.short.1", Description: "Short name of the Persian month of Farvardin", Other: "1月"},
+		{ID: "calendar.persian.month.short.2", Description: "Short name of the Persian month of Ordibehesht", Other: "2月"},
+		{ID: "calendar.persian.month.short.3", Description: "Short name of the Persian month of Khordad", Other: "3月"},
+		{ID: "calendar.persian.month.short.4", Description: "Short name of the Persian month of Tir", Other: "4月"},
+		{ID: "calendar.persian.month.short.5", Description: "Short name of the Persian month of Mordad", Other: "5月"},
+		{ID: "calendar.persian.month.short.6", Description: "Short name of the Persian month of Shahrivar", Other: "6月"},
+		{ID: "calendar.persian.month.short.7", Description: "Short name of the Persian month of Mehr", Other: "7月"},
+		{ID: "calendar.persian.month.short.8", Description: "Short name of the Persian month of Aban", Other: "8月"},
+		{ID: "calendar.persian.month.short.9", Description: "Short name of the Persian month of Azar", Other: "9月"},
+		{ID: "calendar.persian.month.short.10", Description: "Short name of the Persian month of Dey", Other: "10月"},
+		{ID: "calendar.persian.month.short.11", Description: "Short name of the Persian month of Bahman", Other: "11月"},
+		{ID: "calendar.persian.month.short.12", Description: "Short name of the Persian month of Esfand", Other: "12月"},
+		{ID: "date.comma", Description: "Comma between the parts of a date, e.g. before the year in Jan 1, 2023", Other: ", "},
+		{ID: "duration.day", Description: "A duration of whole days, e.g. 3 days", Other: "{{.Count}}天"},
+		{ID: "duration.hour", Description: "A duration of whole hours, e.g. 3 hours", Other: "{{.Count}}小時"},
+		{ID: "duration.minute", Description: "A duration of whole minutes, e.g. 3 minutes", Other: "{{.Count}}分鐘"},
+		{ID: "duration.pair", Description: "A duration made of two units, e.g. 1 hour 30 minutes", Other: "{{.First}}{{.Second}}"},
+		{ID: "duration.second", Description: "A duration of whole seconds, e.g. 3 seconds", Other: "{{.Count}}秒"},
+		{ID: "list.end", Description: "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10", Other: "{{.First}}和{{.Second}}"},
+		{ID: "list.middle", Description: "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5", Other: "{{.First}}、{{.Second}}"},
+		{ID: "month.long.1", Description: "Full name of January", Other: "一月"},
+		{ID: "month.long.2", Description: "Full name of February", Other: "二月"},
+		{ID: "month.long.3", Description: "Full name of March", Other: "三月"},
+		{ID: "month.long.4", Description: "Full name of April", Other: "四月"},
+		{ID: "month.long.5", Description: "Full name of May", Other: "五月"},
+		{ID: "month.long.6", Description: "Full name of June", Other: "六月"},
+		{ID: "month.long.7", Description: "Full name of July", Other: "七月"},
+		{ID: "month.long.8", Description: "Full name of August", Other: "八月"},
+		{ID: "month.long.9", Description: "Full name of September", Other: "九月"},
+		{ID: "month.long.10", Description: "Full name of October", Other: "十月"},
+		{ID: "month.long.11", Description: "Full name of November", Other: "十一月"},
+		{ID: "month.long.12", Description: "Full name of December", Other: "十二月"},
+		{ID: "month.short.1", Description: "Short name of January", Other: "1月"},
+		{ID: "month.short.2", Description: "Short name of February", Other: "2月"},
+		{ID: "month.short.3", Description: "Short name of March", Other: "3月"},
+		{ID: "month.short.4", Description: "Short name of April", Other: "4月"},
+		{ID: "month.short.5", Description: "Short name of May", Other: "5月"},
+		{ID: "month.short.6", Description: "Short name of June", Other: "6月"},
+		{ID: "month.short.7", Description: "Short name of July", Other: "7月"},
+		{ID: "month.short.8", Description: "Short name of August", Other: "8月"},
+		{ID: "month.short.9", Description: "Short name of September", Other: "9月"},
+		{ID: "month.short.10", Description: "Short name of October", Other: "10月"},
+		{ID: "month.short.11", Description: "Short name of November", Other: "11月"},
+		{ID: "month.short.12", Description: "Short name of December", Other: "12月"},
+		{ID: "recurrence.daily", Description: "A rule repeating every day", Other: "每天"},
+		{ID: "recurrence.daily.interval", Description: "A rule repeating every few days", Other: "每{{.Count}}天"},
+		{ID: "recurrence.lastday", Description: "The last day of the month", Other: "最後一天"},
+		{ID: "recurrence.monthday", Description: "A day of the month a rule repeats on, e.g. day 15", Other: "{{.Day}}日"},
+		{ID: "recurrence.monthly", Description: "A rule repeating every month", Other: "每月"},
+		{ID: "recurrence.monthly.interval", Description: "A rule repeating every few months", Other: "每{{.Count}}個月"},
+		{ID: "recurrence.nth.1", Description: "First, as in the first Monday of the month", Other: "第1個"},
+		{ID: "recurrence.nth.2", Description: "Second, as in the second Monday of the month", Other: "第2個"},
+		{ID: "recurrence.nth.3", Description: "Third, as in the third Monday of the month", Other: "第3個"},
+		{ID: "recurrence.nth.4", Description: "Fourth, as in the fourth Monday of the month", Other: "第4個"},
+		{ID: "recurrence.nth.5", Description: "Fifth, as in the fifth Monday of the month", Other: "第5個"},
+		{ID: "recurrence.nth.last", Description: "Last, as in the last Monday of the month", Other: "最後一個"},
+		{ID: "recurrence.nthweekday", Description: "A numbered weekday of the month, e.g. the 2nd Tuesday", Other: "{{.Nth}}{{.Weekday}}"},
+		{ID: "recurrence.on", Description: "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday", Other: "{{.Frequency}}{{.Days}}"},
+		{ID: "recurrence.once", Description: "A rule that occurs a single time", Other: "1次"},
+		{ID: "recurrence.times", Description: "The number of times a rule occurs", Other: "{{.Count}}次"},
+		{ID: "recurrence.weekly", Description: "A rule repeating every week", Other: "每週"},
+		{ID: "recurrence.weekly.days", Description: "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday", Other: "每{{.Days}}"},
+		{ID: "recurrence.weekly.interval", Description: "A rule repeating every few weeks", Other: "每{{.Count}}週"},
+		{ID: "recurrence.weekly.interval.days", Description: "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday", Other: "每{{.Count}}週的{{.Days}}"},
+		{ID: "recurrence.yearly", Description: "A rule repeating every year", Other: "每年"},
+		{ID: "recurrence.yearly.interval", Description: "A rule repeating every few years", Other: "每{{.Count}}年"},
+		{ID: "relative.future.day", Description: "A day or more in the future, e.g. in 3 days", Other: "{{.Count}}天後"},
+		{ID: "relative.future.hour", Description: "A hour or more in the future, e.g. in 3 hours", Other: "{{.Count}}小時後"},
+		{ID: "relative.future.minute", Description: "A minute or more in the future, e.g. in 3 minutes", Other: "{{.Count}}分鐘後"},
+		{ID: "relative.future.month", Description: "A month or more in the future, e.g. in 3 months", Other: "{{.Count}}個月後"},
+		{ID: "relative.future.week", Description: "A week or more in the future, e.g. in 3 weeks", Other: "{{.Count}}週後"},
+		{ID: "relative.future.year", Description: "A year or more in the future, e.g. in 3 years", Other: "{{.Count}}年後"},
+		{ID: "relative.now", Description: "A moment that is less than a minute away from now", Other: "現在"},
+		{ID: "relative.past.day", Description: "A day or more in the past, e.g. 3 days ago", Other: "{{.Count}}天前"},
+		{ID: "relative.past.hour", Description: "A hour or more in the past, e.g. 3 hours ago", Other: "{{.Count}}小時前"},
+		{ID: "relative.past.minute", Description: "A minute or more in the past, e.g. 3 minutes ago", Other: "{{.Count}}分鐘前"},
+		{ID: "relative.past.month", Description: "A month or more in the past, e.g. 3 months ago", Other: "{{.Count}}個月前"},
+		{ID: "relative.past.week", Description: "A week or more in the past, e.g. 3 weeks ago", Other: "{{.Count}}週前"},
+		{ID: "relative.past.year", Description: "A year or more in the past, e.g. 3 years ago", Other: "{{.Count}}年前"},
+		{ID: "weekday.long.0", Description: "Full name of Sunday", Other: "星期日"},
+		{ID: "weekday.long.1", Description: "Full name of Monday", Other: "星期一"},
+		{ID: "weekday.long.2", Description: "Full name of Tuesday", Other: "星期二"},
+		{ID: "weekday.long.3", Description: "Full name of Wednesday", Other: "星期三"},
+		{ID: "weekday.long.4", Description: "Full name of Thursday", Other: "星期四"},
+		{ID: "weekday.long.5", Description: "Full name of Friday", Other: "星期五"},
+		{ID: "weekday.long.6", Description: "Full name of Saturday", Other: "星期六"},
+		{ID: "weekday.short.0", Description: "Short name of Sunday", Other: "日"},
+		{ID: "weekday.short.1", Description: "Short name of Monday", Other: "一"},
+		{ID: "weekday.short.2", Description: "Short name of Tuesday", Other: "二"},
+		{ID: "weekday.short.3", Description: "Short name of Wednesday", Other: "三"},
+		{ID: "weekday.short.4", Description: "Short name of Thursday", Other: "四"},
+		{ID: "weekday.short.5", Description: "Short name of Friday", Other: "五"},
+		{ID: "weekday.short.6", Description: "Short name of Saturday", Other: "六"},
+	},
+}
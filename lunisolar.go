@@ -0,0 +1,275 @@
+package littledate
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Chinese is the Chinese lunisolar calendar. Its months start on the day of
+// a new moon, and a year in which 13 new moons follow each other before the
+// winter solstice comes back repeats one month as a leap month, named after
+// the month before it. Months are numbered in the order they occur, so in a
+// year with a leap second month the third month is month 4. The calendar
+// covers the New Year of 1900 (January 31) to the end of 2100; other dates
+// are left in the Gregorian calendar.
+//
+// Examples:
+// - Mo1 1 - 5
+// - 正月初一 - 初五
+// - 闰二月 2023
+var Chinese Calendar = lunisolar{}
+
+// Vietnamese is the Vietnamese lunisolar calendar. It follows the new moons
+// at UTC+7 since 1968 (UTC+8 before), so that some months, and New Year
+// (Tết), can begin a day or even a month earlier than in the Chinese calendar.
+//
+// Examples:
+// - Mùng 1 - 5 Tết
+// - 28 tháng Chạp - Mùng 3 Tết
+// - tháng Giêng 2023
+var Vietnamese Calendar = lunisolar{vietnamese: true}
+
+// lunisolarFirstYear is the first year of chineseYears and vietnameseYears
+const lunisolarFirstYear = 1900
+
+// lunisolarEpoch is the New Year of 1900 (January 31, 1900) in both
+// calendars, in days since January 1, 1970
+const lunisolarEpoch = -25537
+
+// chineseYears holds the months of the Chinese calendar from 1900, one entry
+// per year, computed from the new moons and solar terms at UTC+8 (the local
+// time of Beijing before 1929). Bit m-1 is set if month m has 30 days, and
+// bits 13 to 16 hold the number of the month that is followed by a leap
+// month, or 0 in years of 12 months.
+var chineseYears = [...]uint32{
+	0x116d2, 0x00752, 0x00ea5, 0x0b64a, 0x0064b, 0x00a9b, 0x0955a, 0x0056a,
+	0x00b59, 0x05752, 0x00752, 0x0db25, 0x00b25, 0x00a4b, 0x0b4ab, 0x002ad,
+	0x0056b, 0x06b69, 0x00da9, 0x0fd92, 0x00e92, 0x00d25, 0x0da4d, 0x00a56,
+	0x002b6, 0x095b5, 0x006d4, 0x00ea9, 0x05e92, 0x00e92, 0x0cd26, 0x0052b,
+	0x00a57, 0x0b2b6, 0x00b5a, 0x006d4, 0x06ec9, 0x00749, 0x0f693, 0x00a93,
+	0x0052b, 0x0ca5b, 0x00aad, 0x0056a, 0x09b55, 0x00ba4, 0x00b49, 0x05a93,
+	0x00a95, 0x0f52d, 0x00536, 0x00aad, 0x0b5aa, 0x005b2, 0x00da5, 0x07d4a,
+	0x00d4a, 0x10a95, 0x00a97, 0x00556, 0x0cab5, 0x00ad5, 0x006d2, 0x08ea5,
+	0x00ea5, 0x0064a, 0x06c97, 0x00a9b, 0x0f55a, 0x0056a, 0x00b69, 0x0b752,
+	0x00b52, 0x00b25, 0x0964b, 0x00a4b, 0x114ab, 0x002ad, 0x0056d, 0x0cb69,
+	0x00da9, 0x00d92, 0x09d25, 0x00d25, 0x15a4d, 0x00a56, 0x002b6, 0x0c5b5,
+	0x006d5, 0x00ea9, 0x0be92, 0x00e92, 0x00d26, 0x06a56, 0x00a57, 0x114d6,
+	0x0035a, 0x006d5, 0x0b6c9, 0x00749, 0x00693, 0x0952b, 0x0052b, 0x00a5b,
+	0x0555a, 0x0056a, 0x0fb55, 0x00ba4, 0x00b49, 0x0ba93, 0x00a95, 0x0052d,
+	0x08aad, 0x00ab5, 0x135aa, 0x005d2, 0x00da5, 0x0dd4a, 0x00d4a, 0x00c95,
+	0x0952e, 0x00556, 0x00ab5, 0x055b2, 0x006d2, 0x0cea5, 0x00725, 0x0064b,
+	0x0ac97, 0x00cab, 0x0055a, 0x06ad6, 0x00b69, 0x17752, 0x00b52, 0x00b25,
+	0x0da4b, 0x00a4b, 0x004ab, 0x0a55b, 0x005ad, 0x00b6a, 0x05b52, 0x00d92,
+	0x0fd25, 0x00d25, 0x00a55, 0x0b4ad, 0x004b6, 0x005b5, 0x06daa, 0x00ec9,
+	0x11e92, 0x00e92, 0x00d26, 0x0ca56, 0x00a57, 0x00556, 0x086d5, 0x00755,
+	0x00749, 0x06e93, 0x00693, 0x0f52b, 0x0052b, 0x00a5b, 0x0b55a, 0x0056a,
+	0x00b65, 0x0974a, 0x00b4a, 0x11a95, 0x00a95, 0x0052d, 0x0caad, 0x00ab5,
+	0x005aa, 0x08ba5, 0x00da5, 0x00d4a, 0x07c95, 0x00c96, 0x0f94e, 0x00556,
+	0x00ab5, 0x0b5b2, 0x006d2, 0x00ea5, 0x08e4a, 0x0068b, 0x10c97, 0x004ab,
+	0x0055b, 0x0cad6, 0x00b6a, 0x00752, 0x09725, 0x00b25, 0x00a8b, 0x0549b,
+	0x004ab,
+}
+
+// vietnameseYears holds the months of the Vietnamese calendar from 1900, in
+// the layout of chineseYears
+var vietnameseYears = [len(chineseYears)]uint32{
+	0x116d2, 0x00752, 0x00ea5, 0x0b64a, 0x0064b, 0x00a9b, 0x09556, 0x0056a,
+	0x00b59, 0x05752, 0x00752, 0x0db25, 0x00b25, 0x00a4b, 0x0b2ab, 0x00aad,
+	0x0056a, 0x04b69, 0x00da9, 0x0fd92, 0x00d92, 0x00d25, 0x0ba4d, 0x00a56,
+	0x002b6, 0x095b5, 0x006d4, 0x00ea9, 0x05e92, 0x00e92, 0x0cd26, 0x0052b,
+	0x00a57, 0x0b2b6, 0x00b5a, 0x006d4, 0x06ec9, 0x00749, 0x0f693, 0x00a93,
+	0x0052b, 0x0ca5b, 0x00aad, 0x0056a, 0x09b55, 0x00ba4, 0x00b49, 0x05a93,
+	0x00a95, 0x0f52d, 0x00536, 0x00aad, 0x0b5aa, 0x005b2, 0x00da5, 0x07d4a,
+	0x00d4a, 0x10a95, 0x00a97, 0x00556, 0x0cab5, 0x00ad5, 0x006d2, 0x08ea5,
+	0x00ea5, 0x0064a, 0x06c97, 0x0029b, 0x0e55b, 0x0056b, 0x00b69, 0x0b752,
+	0x00b52, 0x00b25, 0x09a4b, 0x00a4d, 0x114ab, 0x002ad, 0x005ad, 0x0cb6a,
+	0x00da9, 0x00d92, 0x09d25, 0x00d25, 0x00a55, 0x054ad, 0x004b6, 0x0e5b5,
+	0x006d5, 0x00ec9, 0x0be92, 0x00e92, 0x00d26, 0x06a56, 0x00a57, 0x11556,
+	0x0056a, 0x00b55, 0x0b6c9, 0x00749, 0x00693, 0x0952b, 0x0052b, 0x00a5b,
+	0x0555a, 0x0056a, 0x0eb65, 0x00ba5, 0x00d49, 0x0ba95, 0x00a95, 0x0052d,
+	0x08aad, 0x00ab5, 0x135aa, 0x005d2, 0x00da5, 0x0dd4a, 0x00e4a, 0x00c96,
+	0x0992e, 0x00556, 0x00ab5, 0x055b2, 0x006d2, 0x0cea5, 0x00725, 0x0064b,
+	0x0ac97, 0x004ab, 0x0055b, 0x06ada, 0x00b6a, 0x17752, 0x00b92, 0x00b25,
+	0x0da4b, 0x00a4d, 0x004ad, 0x0a95b, 0x005ad, 0x00baa, 0x05b52, 0x00d92,
+	0x0fd25, 0x00d25, 0x00a55, 0x0b4ad, 0x004b6, 0x006b5, 0x06daa, 0x00eca,
+	0x10e92, 0x00e93, 0x00d26, 0x0ca56, 0x00a5b, 0x0055a, 0x08ad5, 0x00b55,
+	0x0074a, 0x06e93, 0x00a93, 0x0f52b, 0x0052b, 0x00a9b, 0x0b55a, 0x0056a,
+	0x00b65, 0x0974a, 0x00d4a, 0x11a95, 0x00a95, 0x0092d, 0x0caad, 0x00ab5,
+	0x005aa, 0x08ba5, 0x00ea5, 0x00d4a, 0x07d15, 0x00c96, 0x0f956, 0x00556,
+	0x00ab5, 0x0b6b4, 0x006d4, 0x00ea5, 0x08e8a, 0x0068b, 0x11497, 0x004ab,
+	0x0095b, 0x0cada, 0x00b6a, 0x00754, 0x09725, 0x00b45, 0x00a8b, 0x0552b,
+	0x004ad,
+}
+
+// lunisolarTable is the months of a lunisolar calendar and the day number of
+// every New Year in it, followed by the day after the last year
+type lunisolarTable struct {
+	years  []uint32
+	starts []int
+}
+
+var (
+	chineseTable    = newLunisolarTable(chineseYears[:])
+	vietnameseTable = newLunisolarTable(vietnameseYears[:])
+)
+
+func newLunisolarTable(years []uint32) *lunisolarTable {
+	starts := make([]int, len(years)+1)
+	starts[0] = lunisolarEpoch
+	for i, entry := range years {
+		starts[i+1] = starts[i]
+		for month := 1; month <= lunisolarMonths(entry); month++ {
+			starts[i+1] += lunisolarMonthLength(entry, month)
+		}
+	}
+	return &lunisolarTable{years: years, starts: starts}
+}
+
+type lunisolar struct {
+	vietnamese bool
+}
+
+func (c lunisolar) table() *lunisolarTable {
+	if c.vietnamese {
+		return vietnameseTable
+	}
+	return chineseTable
+}
+
+// entry returns the months of a year, if the year is in the table
+func (c lunisolar) entry(year int) (uint32, bool) {
+	years := c.table().years
+	index := year - lunisolarFirstYear
+	if index < 0 || index >= len(years) {
+		return 0, false
+	}
+	return years[index], true
+}
+
+// covers reports whether the date of t is in the table
+func (c lunisolar) covers(t time.Time) bool {
+	starts := c.table().starts
+	days := epochDays(t)
+	return days >= starts[0] && days < starts[len(starts)-1]
+}
+
+func (c lunisolar) Date(t time.Time) (int, int, int) {
+	if !c.covers(t) {
+		return gregorian{}.Date(t)
+	}
+	table := c.table()
+	days := epochDays(t)
+	index := sort.SearchInts(table.starts, days+1) - 1
+	entry := table.years[index]
+	day := days - table.starts[index] + 1
+	month := 1
+	for day > lunisolarMonthLength(entry, month) {
+		day -= lunisolarMonthLength(entry, month)
+		month++
+	}
+	return lunisolarFirstYear + index, month, day
+}
+
+func (c lunisolar) Time(year, month, day int, loc *time.Location) time.Time {
+	entry, ok := c.entry(year)
+	if !ok {
+		return gregorian{}.Time(year, month, day, loc)
+	}
+	days := c.table().starts[year-lunisolarFirstYear] + day - 1
+	for m := 1; m < month; m++ {
+		days += lunisolarMonthLength(entry, m)
+	}
+	return fromEpochDays(days, loc)
+}
+
+func (c lunisolar) MonthsInYear(year int) int {
+	entry, ok := c.entry(year)
+	if !ok {
+		return 12
+	}
+	return lunisolarMonths(entry)
+}
+
+func (c lunisolar) DaysInMonth(year, month int) int {
+	entry, ok := c.entry(year)
+	if !ok {
+		return gregorian{}.DaysInMonth(year, month)
+	}
+	return lunisolarMonthLength(entry, month)
+}
+
+// monthName returns the name of a month, e.g. "正月", or "闰二月" for a leap month.
+// Both calendars share the month names of a locale.
+func (c lunisolar) monthName(locale *localeData, year, month int, long bool) string {
+	entry, ok := c.entry(year)
+	if !ok {
+		return gregorian{}.monthName(locale, year, month, long)
+	}
+	number, leap := lunisolarMonth(entry, month)
+	fallback := "Mo" + strconv.Itoa(number)
+	if long {
+		fallback = "Month " + strconv.Itoa(number)
+	}
+	name := localizedMonthName(locale, "chinese", strconv.Itoa(number), long, fallback)
+	if leap {
+		return locale.localize("calendar.chinese.month.leap", -1, map[string]interface{}{"Month": name})
+	}
+	return name
+}
+
+// appendDays writes the days from and to, which lie in one month, with the
+// name of the month in the order of the locale, e.g. "Mùng 1 - 5 Tết" or
+// "正月初一". The last day is a bare number unless the locale names it, as in
+// "正月初一 - 初五". The separator is left out if from and to are the same day.
+func (c lunisolar) appendDays(b []byte, locale *localeData, from, to time.Time, separator string) []byte {
+	year, month, day := c.Date(from)
+	days := lunisolarDayName(locale, day)
+	if !sameDay(from, to) {
+		_, _, toDay := c.Date(to)
+		days += " " + separator + " " + locale.localize("calendar.chinese.day.end", -1, map[string]interface{}{
+			"Day":  formatNumber(toDay, locale.digits),
+			"Name": lunisolarDayName(locale, toDay),
+		})
+	}
+	return append(b, locale.localize("calendar.chinese.date", -1, map[string]interface{}{
+		"Month": c.monthName(locale, year, month, false),
+		"Day":   days,
+	})...)
+}
+
+// lunisolarDayName returns the name of a day of a lunisolar month, e.g. "初一".
+// Locales that name the days by their number get it in their numbering system.
+func lunisolarDayName(locale *localeData, day int) string {
+	number := strconv.Itoa(day)
+	name := localizeName(locale.localizer, "calendar.chinese.day."+number, number)
+	if name == number {
+		return formatNumber(day, locale.digits)
+	}
+	return name
+}
+
+// lunisolarMonths returns the number of months in a year
+func lunisolarMonths(entry uint32) int {
+	if entry>>13 != 0 {
+		return 13
+	}
+	return 12
+}
+
+func lunisolarMonthLength(entry uint32, month int) int {
+	return 29 + int(entry>>(month-1)&1)
+}
+
+// lunisolarMonth returns the number a month is named after and whether it
+// is the leap month, which repeats the number of the month before it
+func lunisolarMonth(entry uint32, month int) (int, bool) {
+	leap := int(entry >> 13)
+	switch {
+	case leap == 0 || month <= leap:
+		return month, false
+	case month == leap+1:
+		return leap, true
+	}
+	return month - 1, false
+}
@@ -0,0 +1,121 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLunisolarCalendar(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	end := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+	}
+	chinese := DateRangeFormatOptions{Today: today, Locale: "zh-CN", Calendar: Chinese}
+	traditional := DateRangeFormatOptions{Today: today, Locale: "zh-TW", Calendar: Chinese}
+	vietnamese := DateRangeFormatOptions{Today: today, Locale: "vi", Calendar: Vietnamese}
+	english := DateRangeFormatOptions{Today: today, Locale: "en_US", Calendar: Chinese}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		options  DateRangeFormatOptions
+		expected string
+	}{
+		{"days", date(2023, 1, 22), end(2023, 1, 26), chinese, "正月初一 - 初五"},
+		{"full month", date(2023, 1, 22), end(2023, 2, 19), chinese, "正月 2023"},
+		{"leap month", date(2023, 3, 22), end(2023, 4, 19), chinese, "闰二月 2023"},
+		{"across years", date(2023, 1, 19), end(2023, 1, 24), traditional, "臘月廿八 '22 - 正月初三 '23"},
+		{"full day", date(2023, 3, 22), end(2023, 3, 22), traditional, "三, 閏二月初一"},
+		{"english", date(2023, 1, 22), end(2023, 1, 26), english, "Mo1 1 - 5"},
+		{"no quarters", date(2024, 2, 10), end(2024, 5, 7), english, "Mo1 - Mo3 2024"},
+		{"english leap month", date(2023, 3, 22), end(2023, 4, 19), english, "Leap Second Month 2023"},
+		{"vietnamese", date(2023, 1, 22), end(2023, 1, 26), vietnamese, "Mùng 1 - 5 Tết"},
+		{"vietnamese across months", date(2023, 1, 19), end(2023, 1, 24), vietnamese, "28 tháng Chạp '22 - Mùng 3 Tết '23"},
+		{"vietnamese full month", date(2023, 1, 22), end(2023, 2, 19), vietnamese, "tháng Giêng 2023"},
+		{"vietnamese leap month", date(2023, 3, 22), end(2023, 3, 22), vietnamese, "T4, Mùng 1 tháng 2 nhuận"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDateRange(tt.from, tt.to, tt.options)
+			if result != tt.expected {
+				t.Errorf("FormatDateRange() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAnnotation(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	end := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+	}
+	vietnamese := DateRangeFormatOptions{Today: today, Locale: "vi", Annotation: Vietnamese}
+	chinese := DateRangeFormatOptions{Today: today, Locale: "en_US", Annotation: Chinese}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		options  DateRangeFormatOptions
+		expected string
+	}{
+		{"Tết", date(1985, 1, 21), end(1985, 1, 25), vietnamese, "Th1 21 - 25, 1985 (Mùng 1 - 5 Tết)"},
+		{"Chinese New Year", date(2023, 1, 22), end(2023, 1, 26), chinese, "Jan 22 - 26 (Mo1 1 - 5)"},
+		{"across months", date(2023, 1, 19), end(2023, 1, 24), chinese, "Jan 19 - 24 (Mo12 28 - Mo1 3)"},
+		{"full day", date(2023, 3, 22), end(2023, 3, 22), chinese, "Wed, Mar 22 (Leap Mo2 1)"},
+		{"times are left out", time.Date(2023, 1, 22, 9, 0, 0, 0, time.UTC), time.Date(2023, 1, 22, 11, 0, 0, 0, time.UTC),
+			DateRangeFormatOptions{Today: today, Locale: "en_US", IncludeTime: true, Annotation: Chinese}, "Jan 22, 9am - 11am (Mo1 1)"},
+		{"other calendars", date(2023, 9, 16), end(2023, 9, 20), DateRangeFormatOptions{Today: today, Annotation: Hebrew}, "Sep 16 - 20 (Tishrei 1 - 5)"},
+		{"outside the table", date(1899, 12, 30), end(1900, 1, 2), chinese, "Dec 30 '99 - Jan 2 '00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDateRange(tt.from, tt.to, tt.options)
+			if result != tt.expected {
+				t.Errorf("FormatDateRange() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLunisolarConversion(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar Calendar
+		date     time.Time
+		year     int
+		month    int
+		day      int
+	}{
+		{"first day", Chinese, time.Date(1900, 1, 31, 0, 0, 0, 0, time.UTC), 1900, 1, 1},
+		{"leap month", Chinese, time.Date(2023, 3, 22, 0, 0, 0, 0, time.UTC), 2023, 3, 1},
+		{"new moon near midnight", Chinese, time.Date(2057, 9, 28, 0, 0, 0, 0, time.UTC), 2057, 9, 1},
+		{"Chinese New Year 2030", Chinese, time.Date(2030, 2, 3, 0, 0, 0, 0, time.UTC), 2030, 1, 1},
+		{"Tết 2030", Vietnamese, time.Date(2030, 2, 3, 0, 0, 0, 0, time.UTC), 2030, 1, 2},
+		{"Chinese New Year 2007", Chinese, time.Date(2007, 2, 18, 0, 0, 0, 0, time.UTC), 2007, 1, 1},
+		{"Tết 2007", Vietnamese, time.Date(2007, 2, 17, 0, 0, 0, 0, time.UTC), 2007, 1, 1},
+		{"Chinese New Year 1968", Chinese, time.Date(1968, 1, 30, 0, 0, 0, 0, time.UTC), 1968, 1, 1},
+		{"Tết 1968 on UTC+7", Vietnamese, time.Date(1968, 1, 29, 0, 0, 0, 0, time.UTC), 1968, 1, 1},
+		{"Tết 1985", Vietnamese, time.Date(1985, 1, 21, 0, 0, 0, 0, time.UTC), 1985, 1, 1},
+		{"last day", Vietnamese, time.Date(2100, 12, 31, 0, 0, 0, 0, time.UTC), 2100, 12, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, month, day := tt.calendar.Date(tt.date)
+			if year != tt.year || month != tt.month || day != tt.day {
+				t.Errorf("Date() = %d-%d-%d, want %d-%d-%d", year, month, day, tt.year, tt.month, tt.day)
+			}
+			if start := tt.calendar.Time(year, month, day, time.UTC); !start.Equal(tt.date) {
+				t.Errorf("Time() = %v, want %v", start, tt.date)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package littledate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NumberingSystem is the set of characters numbers are written with, named
+// by its CLDR identifier, e.g. "arab" for ٠١٢٣٤٥٦٧٨٩. Days, years, hours,
+// minutes and the numbers in phrases such as "in 3 days" are all written
+// with it; ISO 8601 intervals always use Latin digits.
+type NumberingSystem string
+
+// The numbering systems with decimal digits
+const (
+	LatinDigits      NumberingSystem = "latn"    // 0123456789
+	ArabicDigits     NumberingSystem = "arab"    // ٠١٢٣٤٥٦٧٨٩
+	PersianDigits    NumberingSystem = "arabext" // ۰۱۲۳۴۵۶۷۸۹
+	BengaliDigits    NumberingSystem = "beng"    // ০১২৩৪৫৬৭৮৯
+	DevanagariDigits NumberingSystem = "deva"    // ०१२३४५६७८९
+	MyanmarDigits    NumberingSystem = "mymr"    // ၀၁၂၃၄၅၆၇၈၉
+	ThaiDigits       NumberingSystem = "thai"    // ๐๑๒๓๔๕๖๗๘๙
+	HanDecimalDigits NumberingSystem = "hanidec" // 〇一二三四五六七八九
+)
+
+// The Chinese and Japanese numerals of formal writing. Numbers below 100
+// are written with 十, e.g. "十二月", and others digit by digit like
+// HanDecimalDigits, e.g. "二〇二三年".
+const (
+	ChineseNumerals  NumberingSystem = "hans"
+	JapaneseNumerals NumberingSystem = "jpan"
+)
+
+// decimalDigits are the digits 0 to 9 of the numbering systems other than
+// LatinDigits. The Han numerals share the digits of HanDecimalDigits.
+var decimalDigits = map[NumberingSystem]*[10]string{
+	ArabicDigits:     {"٠", "١", "٢", "٣", "٤", "٥", "٦", "٧", "٨", "٩"},
+	PersianDigits:    {"۰", "۱", "۲", "۳", "۴", "۵", "۶", "۷", "۸", "۹"},
+	BengaliDigits:    {"০", "১", "২", "৩", "৪", "৫", "৬", "৭", "৮", "৯"},
+	DevanagariDigits: {"०", "१", "२", "३", "४", "५", "६", "७", "८", "९"},
+	MyanmarDigits:    {"၀", "၁", "၂", "၃", "၄", "၅", "၆", "၇", "၈", "၉"},
+	ThaiDigits:       {"๐", "๑", "๒", "๓", "๔", "๕", "๖", "๗", "๘", "๙"},
+	"olck":           {"᱐", "᱑", "᱒", "᱓", "᱔", "᱕", "᱖", "᱗", "᱘", "᱙"},
+	"tibt":           {"༠", "༡", "༢", "༣", "༤", "༥", "༦", "༧", "༨", "༩"},
+	HanDecimalDigits: {"〇", "一", "二", "三", "四", "五", "六", "七", "八", "九"},
+}
+
+// hanNumerals lists the numbering systems written with 十
+var hanNumerals = map[NumberingSystem]bool{
+	ChineseNumerals:  true,
+	"hant":           true,
+	JapaneseNumerals: true,
+}
+
+// defaultNumberingSystems lists the locales whose numbers are not written
+// with Latin digits by default, following CLDR. Regions that differ from
+// their language are listed on their own.
+var defaultNumberingSystems = map[string]NumberingSystem{
+	"ar":    ArabicDigits,
+	"ar-AE": LatinDigits,
+	"ar-DZ": LatinDigits,
+	"ar-EH": LatinDigits,
+	"ar-LY": LatinDigits,
+	"ar-MA": LatinDigits,
+	"ar-TN": LatinDigits,
+	"as":    BengaliDigits,
+	"bn":    BengaliDigits,
+	"ckb":   ArabicDigits,
+	"dz":    "tibt",
+	"fa":    PersianDigits,
+	"ks":    PersianDigits,
+	"mni":   BengaliDigits,
+	"mr":    DevanagariDigits,
+	"my":    MyanmarDigits,
+	"ne":    DevanagariDigits,
+	"ps":    PersianDigits,
+	"sat":   "olck",
+	"sd":    ArabicDigits,
+	"ur-IN": PersianDigits,
+}
+
+// ParseNumberingSystem returns the numbering system with the given CLDR
+// identifier, e.g. "arab" or "hanidec". It is meant for configuration files
+// and command lines.
+func ParseNumberingSystem(name string) (NumberingSystem, error) {
+	system := NumberingSystem(strings.ToLower(strings.TrimSpace(name)))
+	if system != LatinDigits && decimalDigits[system] == nil && !hanNumerals[system] {
+		return "", fmt.Errorf("littledate: unknown numbering system %q", name)
+	}
+	return system, nil
+}
+
+// localeNumberingSystem returns the numbering system of a locale string,
+// which may be chosen with a "-u-nu-" extension, e.g. "th-TH-u-nu-thai"
+func localeNumberingSystem(locale string) NumberingSystem {
+	tag, err := parseLocale(locale)
+	if err != nil {
+		return LatinDigits
+	}
+	if system, err := ParseNumberingSystem(tag.TypeForKey("nu")); err == nil {
+		return system
+	}
+	for _, candidate := range fallbackChain(tag) {
+		if system, ok := defaultNumberingSystems[candidate]; ok {
+			return system
+		}
+	}
+	return LatinDigits
+}
+
+// digitsOf returns the digits of a numbering system, or nil for LatinDigits
+// and unknown systems. The Han numerals share the digits of HanDecimalDigits.
+func digitsOf(system NumberingSystem) *[10]string {
+	if hanNumerals[system] {
+		return decimalDigits[HanDecimalDigits]
+	}
+	return decimalDigits[system]
+}
+
+// appendNumber appends n in a numbering system, e.g. 12 as "١٢", or as "十二"
+// in Han numerals. Only the numbers of a date are written this way, never
+// the digits of a name or separator.
+func appendNumber(b []byte, n int, system NumberingSystem) []byte {
+	digits := digitsOf(system)
+	if digits == nil {
+		return strconv.AppendInt(b, int64(n), 10)
+	}
+	var buf [20]byte
+	number := strconv.AppendInt(buf[:0], int64(n), 10)
+	if hanNumerals[system] {
+		return appendHanNumber(b, number, digits)
+	}
+	return appendDigits(b, number, digits)
+}
+
+// appendTwoDigitNumber appends n zero-padded to two digits in a numbering
+// system, e.g. "05" or "٠٥". Padded numbers such as minutes and the year of
+// "'22" are read digit by digit, so Han numerals write them like
+// HanDecimalDigits, e.g. "〇五".
+func appendTwoDigitNumber(b []byte, n int, system NumberingSystem) []byte {
+	digits := digitsOf(system)
+	if digits == nil {
+		return appendTwoDigits(b, n)
+	}
+	var buf [20]byte
+	return appendDigits(b, appendTwoDigits(buf[:0], n), digits)
+}
+
+// formatNumber is appendNumber for numbers passed to messages
+func formatNumber(n int, system NumberingSystem) string {
+	var buf [64]byte
+	return string(appendNumber(buf[:0], n, system))
+}
+
+// appendDigits appends a run of Latin digits digit by digit in other digits
+func appendDigits(b []byte, number []byte, digits *[10]string) []byte {
+	for _, c := range number {
+		b = append(b, digits[c-'0']...)
+	}
+	return b
+}
+
+// appendHanNumber writes a run of Latin digits in Han numerals, e.g. "12" as
+// "十二". Longer and zero-padded runs are written digit by digit.
+func appendHanNumber(b []byte, number []byte, digits *[10]string) []byte {
+	if len(number) != 2 || number[0] == '0' {
+		return appendDigits(b, number, digits)
+	}
+
+	// 10 is "十", 12 "十二", 20 "二十" and 21 "二十一"
+	tens, ones := number[0]-'0', number[1]-'0'
+	if tens > 1 {
+		b = append(b, digits[tens]...)
+	}
+	b = append(b, "十"...)
+	if ones > 0 {
+		b = append(b, digits[ones]...)
+	}
+	return b
+}
@@ -0,0 +1,105 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNumberingSystem(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	end := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		options  DateRangeFormatOptions
+		expected string
+	}{
+		{"arabic by default", date(2023, 1, 1), end(2023, 1, 12), DateRangeFormatOptions{Today: today, Locale: "ar-EG"}, "١ - ١٢ يناير"},
+		{"latin in the Maghreb", date(2023, 1, 1), end(2023, 1, 12), DateRangeFormatOptions{Today: today, Locale: "ar-MA"}, "1 - 12 يناير"},
+		{"persian by default", date(2023, 12, 1), end(2023, 12, 31), DateRangeFormatOptions{Today: today, Locale: "fa"}, "دسامبر ۲۰۲۳"},
+		{"bengali by default", date(2023, 1, 1), end(2023, 1, 12), DateRangeFormatOptions{Today: today, Locale: "bn"}, "Jan ১ - ১২"},
+		{"latin thai by default", date(2023, 1, 1), end(2023, 1, 12), DateRangeFormatOptions{Today: today, Locale: "th"}, "ม.ค. 1 - 12"},
+		{"locale extension", date(2023, 1, 1), end(2023, 1, 12), DateRangeFormatOptions{Today: today, Locale: "th-TH-u-nu-thai"}, "ม.ค. ๑ - ๑๒"},
+		{"option", date(2023, 1, 1), end(2023, 1, 12), DateRangeFormatOptions{Today: today, NumberingSystem: DevanagariDigits}, "Jan १ - १२"},
+		{"option over locale", date(2023, 1, 1), end(2023, 1, 12), DateRangeFormatOptions{Today: today, Locale: "ar-EG", NumberingSystem: LatinDigits}, "1 - 12 يناير"},
+		{"times", time.Date(2022, 12, 30, 9, 5, 0, 0, time.UTC), time.Date(2022, 12, 30, 14, 30, 0, 0, time.UTC),
+			DateRangeFormatOptions{Today: today, Locale: "ar", IncludeTime: true}, "٣٠ ديسمبر، ٩:٠٥ - ١٤:٣٠، ٢٠٢٢"},
+		{"chinese numerals", date(2023, 12, 1), end(2023, 12, 31), DateRangeFormatOptions{Today: today, Locale: "zh-CN", NumberingSystem: ChineseNumerals}, "十二月 二〇二三"},
+		{"han decimal digits", date(2023, 12, 1), end(2023, 12, 15), DateRangeFormatOptions{Today: today, Locale: "zh-CN", NumberingSystem: HanDecimalDigits}, "12月 一 - 一五"},
+		{"han across years", date(2022, 12, 30), end(2023, 1, 2), DateRangeFormatOptions{Today: today, NumberingSystem: ChineseNumerals}, "Dec 三十 '二二 - Jan 二 '二三"},
+		{"han quarter", date(2023, 1, 1), end(2023, 3, 31), DateRangeFormatOptions{Today: today, NumberingSystem: ChineseNumerals}, "Q1 二〇二三"},
+		{"han times", time.Date(2023, 1, 3, 9, 5, 0, 0, time.UTC), time.Date(2023, 1, 3, 14, 30, 0, 0, time.UTC),
+			DateRangeFormatOptions{Today: today, NumberingSystem: ChineseNumerals, IncludeTime: true}, "Jan 三, 九:〇五am - 二:三〇pm"},
+		{"digits in the separator", date(2023, 1, 1), end(2023, 1, 12), DateRangeFormatOptions{Today: today, Separator: "–1–", NumberingSystem: ArabicDigits}, "Jan ١ –1– ١٢"},
+		{"digits in month names", date(2023, 1, 1), end(2023, 1, 12), DateRangeFormatOptions{Today: today, Locale: "vi", NumberingSystem: ArabicDigits}, "Th1 ١ - ١٢"},
+		{"digits in quarters", date(2023, 1, 1), end(2023, 3, 31), DateRangeFormatOptions{Today: today, Locale: "vi", NumberingSystem: ArabicDigits}, "Q1 ٢٠٢٣"},
+		{"japanese numerals", date(2023, 1, 1), end(2023, 1, 12), DateRangeFormatOptions{Today: today, Locale: "ja", NumberingSystem: JapaneseNumerals, Calendar: JapaneseEra}, "令和五年一月一日～十二日"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDateRange(tt.from, tt.to, tt.options)
+			if result != tt.expected {
+				t.Errorf("FormatDateRange() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNumberingSystemPhrases(t *testing.T) {
+	arabic := DateRangeFormatOptions{Today: today, Locale: "ar"}
+	persian := DateRangeFormatOptions{Today: today, Locale: "fa"}
+
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{"date", FormatDate(time.Date(2023, 12, 15, 0, 0, 0, 0, time.UTC), arabic), "الجمعة، ١٥ ديسمبر"},
+		{"relative", FormatRelative(today.AddDate(0, 0, 3), arabic), "خلال ٣ أيام"},
+		{"duration", FormatDuration(90*time.Minute, persian), "۱ ساعت و ۳۰ دقیقه"},
+		{"time", FormatTime(time.Date(2023, 1, 1, 14, 30, 0, 0, time.UTC), "ar"), "١٤:٣٠"},
+		{"ISO interval", FormatISOInterval(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 12, 23, 59, 59, 0, time.UTC), arabic), "2023-01-01/12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("result = %v, want %v", tt.result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseNumberingSystem(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    NumberingSystem
+		wantErr bool
+	}{
+		{"latn", LatinDigits, false},
+		{" ARAB ", ArabicDigits, false},
+		{"arabext", PersianDigits, false},
+		{"hanidec", HanDecimalDigits, false},
+		{"jpan", JapaneseNumerals, false},
+		{"roman", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseNumberingSystem(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseNumberingSystem() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseNumberingSystem() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
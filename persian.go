@@ -0,0 +1,123 @@
+package littledate
+
+import (
+	"strconv"
+	"time"
+)
+
+// Persian is the Solar Hijri calendar of Iran and Afghanistan. Its year
+// starts at the March equinox; the first six months have 31 days, the next
+// five 30 days and Esfand 29 days, or 30 in leap years. Leap years follow
+// the astronomical calendar from 1 to 3177 AP (622 to 3798).
+//
+// Examples:
+// - ۱ - ۱۲ فروردین، ۱۴۰۲
+// - Farvardin 1402
+// - Esfand 25 '01 - Farvardin 5 '02
+var Persian Calendar = persian{}
+
+// persianMonths are the English month names, used when a locale has none
+var persianMonths = [12]string{
+	"Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar",
+	"Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand",
+}
+
+// persianBreaks are the years in which the 33-year leap cycle of the Persian
+// calendar is interrupted to follow the equinox (Borkowski, 1996)
+var persianBreaks = [...]int{
+	-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210,
+	1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178,
+}
+
+type persian struct{}
+
+func (persian) Date(t time.Time) (int, int, int) {
+	days := epochDays(t)
+	year := t.Year() - 621
+	if days < persianNewYear(year) {
+		year--
+	}
+
+	// The first six months have 31 days and the others 30
+	day := days - persianNewYear(year)
+	if day < 186 {
+		return year, day/31 + 1, day%31 + 1
+	}
+	day -= 186
+	return year, day/30 + 7, day%30 + 1
+}
+
+func (persian) Time(year, month, day int, loc *time.Location) time.Time {
+	days := persianNewYear(year) + day - 1
+	if month <= 7 {
+		days += (month - 1) * 31
+	} else {
+		days += 186 + (month-7)*30
+	}
+	return fromEpochDays(days, loc)
+}
+
+func (persian) MonthsInYear(year int) int {
+	return 12
+}
+
+func (persian) DaysInMonth(year, month int) int {
+	switch {
+	case month <= 6:
+		return 31
+	case month <= 11:
+		return 30
+	case isPersianLeapYear(year):
+		return 30
+	}
+	return 29
+}
+
+func (persian) monthName(locale *localeData, year, month int, long bool) string {
+	return localizedMonthName(locale, "persian", strconv.Itoa(month), long, persianMonths[month-1])
+}
+
+// persianNewYear returns the day number of 1 Farvardin of a year
+func persianNewYear(year int) int {
+	march, _ := persianYear(year)
+	return epochDays(time.Date(year+621, time.March, march, 0, 0, 0, 0, time.UTC))
+}
+
+// isPersianLeapYear reports whether Esfand has 30 days in a year
+func isPersianLeapYear(year int) bool {
+	_, leap := persianYear(year)
+	return leap
+}
+
+// persianYear returns the day of March on which a year starts and whether
+// it is a leap year, using the break years of the leap cycle
+func persianYear(year int) (march int, leap bool) {
+	gregorianYear := year + 621
+	leaps := -14
+	previous := persianBreaks[0]
+	jump := 0
+	for _, next := range persianBreaks[1:] {
+		jump = next - previous
+		if year < next {
+			break
+		}
+		leaps += jump/33*8 + jump%33/4
+		previous = next
+	}
+
+	// Leap years in the current cycle up to this year
+	n := year - previous
+	leaps += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leaps++
+	}
+
+	// The Gregorian leap days since the start of the era
+	gregorianLeaps := gregorianYear/4 - (gregorianYear/100+1)*3/4 - 150
+	march = 20 + leaps - gregorianLeaps
+
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	return march, ((n+1)%33-1)%4 == 0
+}
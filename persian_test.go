@@ -0,0 +1,72 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPersianCalendar(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	end := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+	}
+	english := DateRangeFormatOptions{Today: today, Locale: "en_US", Calendar: Persian}
+	persian := DateRangeFormatOptions{Today: today, Locale: "fa", Calendar: Persian}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		options  DateRangeFormatOptions
+		expected string
+	}{
+		{"days", date(2023, 3, 21), end(2023, 4, 1), english, "Farvardin 1 - 12"},
+		{"full month", date(2023, 3, 21), end(2023, 4, 20), english, "Farvardin 1402"},
+		{"Gregorian month is not a full month", date(2023, 4, 1), end(2023, 4, 30), english, "Farvardin 12 - Ordibehesht 10"},
+		{"full year", date(2023, 3, 21), end(2024, 3, 19), english, "1402"},
+		{"quarter", date(2023, 3, 21), end(2023, 6, 21), english, "Q1 1402"},
+		{"leap Esfand", date(2025, 2, 19), end(2025, 3, 20), english, "Esfand 1403"},
+		{"across years", date(2023, 3, 16), end(2023, 3, 25), english, "Esfand 25 '01 - Farvardin 5 '02"},
+		{"persian", date(2023, 3, 21), end(2023, 4, 1), persian, "۱ - ۱۲ فروردین"},
+		{"persian month", date(2023, 9, 23), end(2023, 10, 22), persian, "مهر ۱۴۰۲"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatDateRange(tt.from, tt.to, tt.options)
+			if result != tt.expected {
+				t.Errorf("FormatDateRange() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPersianConversion(t *testing.T) {
+	tests := []struct {
+		date  time.Time
+		year  int
+		month int
+		day   int
+	}{
+		{time.Date(2023, 3, 21, 0, 0, 0, 0, time.UTC), 1402, 1, 1},
+		{time.Date(2024, 3, 19, 0, 0, 0, 0, time.UTC), 1402, 12, 29},
+		{time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), 1403, 1, 1},
+		{time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC), 1403, 12, 30},
+		{time.Date(2023, 9, 23, 0, 0, 0, 0, time.UTC), 1402, 7, 1},
+		{time.Date(1979, 2, 11, 0, 0, 0, 0, time.UTC), 1357, 11, 22},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date.Format("2006-01-02"), func(t *testing.T) {
+			year, month, day := Persian.Date(tt.date)
+			if year != tt.year || month != tt.month || day != tt.day {
+				t.Errorf("Date() = %d-%d-%d, want %d-%d-%d", year, month, day, tt.year, tt.month, tt.day)
+			}
+			if start := Persian.Time(year, month, day, time.UTC); !start.Equal(tt.date) {
+				t.Errorf("Time() = %v, want %v", start, tt.date)
+			}
+		})
+	}
+}
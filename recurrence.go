@@ -0,0 +1,195 @@
+package littledate
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hnq90/little-date-go/internal/rrule"
+)
+
+// FormatRecurrence describes an RFC 5545 recurrence rule starting at dtstart.
+// FREQ (DAILY to YEARLY), INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL are
+// supported; an "RRULE:" prefix is optional. The time of dtstart is shown if
+// options.IncludeTime is set, and UNTIL is shown as a range from dtstart.
+//
+// Examples:
+// - Every Monday and Wednesday, Jan 1 - Mar 31
+// - Monthly on the 2nd Tuesday
+// - Daily, 9am, 10 times
+func FormatRecurrence(rule string, dtstart time.Time, options DateRangeFormatOptions) (string, error) {
+	return formatRecurrence(rule, dtstart, dtstart, false, options)
+}
+
+// FormatRecurringEvent describes a recurring event whose first occurrence
+// lasts from start to end. It is like FormatRecurrence, but shows the times
+// of both the start and the end of the event.
+//
+// Examples:
+// - Daily, 9am - 10am
+// - Every 2 weeks on Friday, 4pm - 5:30pm, Jan 6 - Mar 31
+func FormatRecurringEvent(rule string, start, end time.Time, options DateRangeFormatOptions) (string, error) {
+	return formatRecurrence(rule, start, end, true, options)
+}
+
+// FormatRecurrence describes a recurrence rule, see FormatRecurrence.
+func (f Formatter) FormatRecurrence(rule string, dtstart time.Time) (string, error) {
+	return FormatRecurrence(rule, dtstart, f.Options)
+}
+
+// FormatRecurringEvent describes a recurring event, see FormatRecurringEvent.
+func (f Formatter) FormatRecurringEvent(rule string, start, end time.Time) (string, error) {
+	return FormatRecurringEvent(rule, start, end, f.Options)
+}
+
+// formatRecurrence joins the frequency, the time of day and the bounds of a rule
+func formatRecurrence(value string, start, end time.Time, hasEnd bool, options DateRangeFormatOptions) (string, error) {
+	setDefaults(&options)
+	if options.Location != nil {
+		start = start.In(options.Location)
+		end = end.In(options.Location)
+	}
+
+	rule, err := rrule.Parse(value, start.Location())
+	if err != nil {
+		return "", fmt.Errorf("littledate: invalid recurrence rule: %w", err)
+	}
+
+	locale := localeOf(options)
+	frequency, err := describeFrequency(locale, rule)
+	if err != nil {
+		return "", fmt.Errorf("littledate: invalid recurrence rule: %w", err)
+	}
+	parts := []string{frequency}
+
+	// The time of day, e.g. "9am" or "9am - 10am"
+	if options.IncludeTime {
+		var b []byte
+		switch {
+		case !hasEnd && !isSameMinute(startOfDay(start), start):
+			b = appendTime(b, start, locale.hour24, locale.digits)
+		case hasEnd && !(isSameMinute(startOfDay(start), start) && (isSameMinute(endOfDay(end), end) || end.Equal(startOfDay(end)))):
+			b = appendTime(b, start, locale.hour24, locale.digits)
+			b = append(b, ' ')
+			b = append(b, options.Separator...)
+			b = append(b, ' ')
+			b = appendTime(b, end, locale.hour24, locale.digits)
+		}
+		if len(b) > 0 {
+			parts = append(parts, string(b))
+		}
+	}
+
+	// The bounds, e.g. "Jan 1 - Mar 31" or "10 times"
+	switch {
+	case !rule.Until.IsZero():
+		dates := options
+		dates.IncludeTime = false
+		dates.Bidi = BidiNone
+		parts = append(parts, FormatDateRange(startOfDay(start), endOfDay(rule.Until), dates))
+	case rule.Count == 1:
+		parts = append(parts, locale.localize("recurrence.once", -1, nil))
+	case rule.Count > 1:
+		parts = append(parts, locale.localize("recurrence.times", rule.Count, map[string]interface{}{"Count": formatNumber(rule.Count, locale.digits)}))
+	}
+
+	result := parts[0]
+	for _, part := range parts[1:] {
+		result = locale.localize("list.middle", -1, map[string]interface{}{
+			"First":  result,
+			"Second": part,
+		})
+	}
+	return protectNumbersString(result, options), nil
+}
+
+// frequencyMessages are the message IDs of each frequency
+var frequencyMessages = map[rrule.Frequency]string{
+	rrule.Daily:   "recurrence.daily",
+	rrule.Weekly:  "recurrence.weekly",
+	rrule.Monthly: "recurrence.monthly",
+	rrule.Yearly:  "recurrence.yearly",
+}
+
+// describeFrequency describes how often a rule repeats, e.g. "Every Monday and
+// Wednesday" or "Monthly on the 2nd Tuesday"
+func describeFrequency(locale *localeData, rule rrule.Rule) (string, error) {
+	var frequency string
+	if rule.Interval > 1 {
+		frequency = locale.localize(frequencyMessages[rule.Freq]+".interval", -1, map[string]interface{}{"Count": formatNumber(rule.Interval, locale.digits)})
+	} else {
+		frequency = locale.localize(frequencyMessages[rule.Freq], -1, nil)
+	}
+
+	if len(rule.ByDay) > 0 && len(rule.ByMonthDay) > 0 {
+		return "", errors.New("BYDAY together with BYMONTHDAY is not supported")
+	}
+
+	// Plain weekdays every week, e.g. "Every Monday and Wednesday"
+	if len(rule.ByDay) > 0 && plainWeekdays(rule.ByDay) &&
+		(rule.Freq == rrule.Weekly || (rule.Freq == rrule.Daily && rule.Interval == 1)) {
+		days := make([]string, len(rule.ByDay))
+		for i, day := range rule.ByDay {
+			days[i] = locale.names.weekdays[day.Weekday]
+		}
+		if rule.Freq == rrule.Weekly && rule.Interval > 1 {
+			return locale.localize("recurrence.weekly.interval.days", -1, map[string]interface{}{
+				"Count": formatNumber(rule.Interval, locale.digits),
+				"Days":  joinList(locale, days),
+			}), nil
+		}
+		return locale.localize("recurrence.weekly.days", -1, map[string]interface{}{
+			"Days": joinList(locale, days),
+		}), nil
+	}
+
+	var days []string
+	for _, day := range rule.ByDay {
+		weekday := locale.names.weekdays[day.Weekday]
+		switch {
+		case day.N == 0:
+			days = append(days, weekday)
+		case day.N >= 1 && day.N <= 5:
+			days = append(days, locale.localize("recurrence.nthweekday", -1, map[string]interface{}{
+				"Nth":     locale.localize("recurrence.nth."+strconv.Itoa(day.N), -1, nil),
+				"Weekday": weekday,
+			}))
+		case day.N == -1:
+			days = append(days, locale.localize("recurrence.nthweekday", -1, map[string]interface{}{
+				"Nth":     locale.localize("recurrence.nth.last", -1, nil),
+				"Weekday": weekday,
+			}))
+		default:
+			return "", fmt.Errorf("BYDAY %d is not supported", day.N)
+		}
+	}
+	for _, day := range rule.ByMonthDay {
+		switch {
+		case day > 0:
+			days = append(days, locale.localize("recurrence.monthday", -1, map[string]interface{}{"Day": formatNumber(day, locale.digits)}))
+		case day == -1:
+			days = append(days, locale.localize("recurrence.lastday", -1, nil))
+		default:
+			return "", fmt.Errorf("BYMONTHDAY %d is not supported", day)
+		}
+	}
+	if len(days) == 0 {
+		return frequency, nil
+	}
+
+	return locale.localize("recurrence.on", -1, map[string]interface{}{
+		"Frequency": frequency,
+		"Days":      joinList(locale, days),
+	}), nil
+}
+
+// plainWeekdays reports whether none of the weekdays is numbered
+func plainWeekdays(days []rrule.WeekdayNum) bool {
+	for _, day := range days {
+		if day.N != 0 {
+			return false
+		}
+	}
+	return true
+}
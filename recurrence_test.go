@@ -0,0 +1,111 @@
+package littledate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatRecurrence(t *testing.T) {
+	start := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	morning := time.Date(2023, 1, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		rule      string
+		dtstart   time.Time
+		locale    string
+		numbering NumberingSystem
+		expected  string
+	}{
+		{name: "daily", rule: "FREQ=DAILY", dtstart: start, expected: "Daily"},
+		{name: "every 3 days", rule: "FREQ=DAILY;INTERVAL=3", dtstart: start, expected: "Every 3 days"},
+		{name: "weekdays until", rule: "RRULE:FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20230331", dtstart: start, expected: "Every Monday and Wednesday, Jan 2 - Mar 31"},
+		{name: "every 2 weeks", rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR", dtstart: start, expected: "Every 2 weeks on Friday"},
+		{name: "nth weekday", rule: "FREQ=MONTHLY;BYDAY=2TU", dtstart: start, expected: "Monthly on the 2nd Tuesday"},
+		{name: "last weekday", rule: "FREQ=MONTHLY;INTERVAL=3;BYDAY=-1FR", dtstart: start, expected: "Every 3 months on the last Friday"},
+		{name: "month days", rule: "FREQ=MONTHLY;BYMONTHDAY=1,15,-1", dtstart: start, expected: "Monthly on day 1, day 15 and the last day"},
+		{name: "count with time", rule: "FREQ=DAILY;COUNT=10", dtstart: morning, expected: "Daily, 9am, 10 times"},
+		{name: "once", rule: "FREQ=YEARLY;COUNT=1", dtstart: start, expected: "Yearly, once"},
+		{name: "until in another year", rule: "FREQ=YEARLY;UNTIL=20250101T000000Z", dtstart: start, expected: "Yearly, Jan 2 '23 - Jan 1 '25"},
+		{name: "de", rule: "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=5", dtstart: start, locale: "de", expected: "Jeden Montag und Mittwoch, 5-mal"},
+		{name: "de nth", rule: "FREQ=MONTHLY;BYDAY=2TU", dtstart: start, locale: "de", expected: "Monatlich am 2. Dienstag"},
+		{name: "es", rule: "FREQ=MONTHLY;BYDAY=2TU", dtstart: start, locale: "es", expected: "Mensualmente el segundo Martes"},
+		{name: "fr", rule: "FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20230331", dtstart: start, locale: "fr", expected: "Chaque lundi et mercredi, janv. 2 - mars 31"},
+		{name: "ja", rule: "FREQ=MONTHLY;BYDAY=2TU;COUNT=3", dtstart: start, locale: "ja", expected: "毎月第2火曜日、3回"},
+		{name: "ko", rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR", dtstart: start, locale: "ko", expected: "2주마다 금요일"},
+		{name: "vi", rule: "FREQ=MONTHLY;BYMONTHDAY=15", dtstart: start, locale: "vi", expected: "Hằng tháng vào ngày 15"},
+		{name: "zh-CN", rule: "FREQ=WEEKLY;BYDAY=MO,WE", dtstart: start, locale: "zh-CN", expected: "每星期一和星期三"},
+		{name: "zh-TW", rule: "FREQ=DAILY;INTERVAL=2;COUNT=4", dtstart: morning, locale: "zh-TW", expected: "每2天、9am、4次"},
+		{name: "ar twice", rule: "FREQ=DAILY;COUNT=2", dtstart: start, locale: "ar", expected: "يوميًا، مرتين"},
+		{name: "ar few", rule: "FREQ=DAILY;COUNT=5", dtstart: start, locale: "ar", expected: "يوميًا، ٥ مرات"},
+		{name: "ar many", rule: "FREQ=MONTHLY;BYDAY=2TU;COUNT=12", dtstart: morning, locale: "ar", expected: "شهريًا في الثلاثاء الثاني، ٩:٠٠، ١٢ مرة"},
+		{name: "he twice", rule: "FREQ=DAILY;COUNT=2", dtstart: start, locale: "he", expected: "כל יום, פעמיים"},
+		{name: "ordinal in numerals", rule: "FREQ=MONTHLY;BYDAY=2TU;COUNT=12", dtstart: morning, numbering: ChineseNumerals, expected: "Monthly on the 2nd Tuesday, 九am, 十二 times"},
+		{name: "de ordinal in numerals", rule: "FREQ=MONTHLY;BYDAY=2TU", dtstart: start, locale: "de", numbering: ChineseNumerals, expected: "Monatlich am 2. Dienstag"},
+		{name: "month days in digits", rule: "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=15", dtstart: start, numbering: ArabicDigits, expected: "Every ٣ months on day ١٥"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := DateRangeFormatOptions{Today: today, Locale: tt.locale, NumberingSystem: tt.numbering, IncludeTime: true}
+			result, err := FormatRecurrence(tt.rule, tt.dtstart, options)
+			if err != nil {
+				t.Fatalf("FormatRecurrence() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("FormatRecurrence() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatRecurringEvent(t *testing.T) {
+	tests := []struct {
+		name       string
+		rule       string
+		start, end time.Time
+		expected   string
+	}{
+		{
+			name:     "daily meeting",
+			rule:     "FREQ=DAILY",
+			start:    time.Date(2023, 1, 2, 9, 0, 0, 0, time.UTC),
+			end:      time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC),
+			expected: "Daily, 9am - 10am",
+		},
+		{
+			name:     "bounded",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR;UNTIL=20230331T235959Z",
+			start:    time.Date(2023, 1, 6, 16, 0, 0, 0, time.UTC),
+			end:      time.Date(2023, 1, 6, 17, 30, 0, 0, time.UTC),
+			expected: "Every 2 weeks on Friday, 4pm - 5:30pm, Jan 6 - Mar 31",
+		},
+		{
+			name:     "all day",
+			rule:     "FREQ=YEARLY",
+			start:    time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2023, 1, 7, 0, 0, 0, 0, time.UTC),
+			expected: "Yearly",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FormatRecurringEvent(tt.rule, tt.start, tt.end, defaultOptions)
+			if err != nil {
+				t.Fatalf("FormatRecurringEvent() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("FormatRecurringEvent() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatRecurrenceErrors(t *testing.T) {
+	for _, rule := range []string{"", "FREQ=HOURLY", "FREQ=MONTHLY;BYDAY=-2MO", "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13"} {
+		if _, err := FormatRecurrence(rule, today, defaultOptions); err == nil {
+			t.Errorf("FormatRecurrence(%q) error = nil, want an error", rule)
+		}
+	}
+}
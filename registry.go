@@ -0,0 +1,188 @@
+package littledate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// translations collects the messages of every locale before they are added to a bundle
+type translations struct {
+	// locales lists the known locales in the order they were first added.
+	// The first entry is the default used when no other locale matches.
+	locales  []string
+	messages map[string][]*i18n.Message
+}
+
+func newTranslations() *translations {
+	return &translations{messages: make(map[string][]*i18n.Message)}
+}
+
+// add appends messages for a locale. Later messages override earlier ones with the same ID.
+func (t *translations) add(locale string, messages ...*i18n.Message) {
+	if _, ok := t.messages[locale]; !ok {
+		t.locales = append(t.locales, locale)
+	}
+	t.messages[locale] = append(t.messages[locale], messages...)
+}
+
+// clone returns a copy of the translations that can be added to without changing t
+func (t *translations) clone() *translations {
+	c := &translations{
+		locales:  append([]string(nil), t.locales...),
+		messages: make(map[string][]*i18n.Message, len(t.messages)),
+	}
+	for locale, messages := range t.messages {
+		c.messages[locale] = messages[:len(messages):len(messages)]
+	}
+	return c
+}
+
+// registry holds the translations known to the formatter.
+//
+// Readers never lock: they load the current snapshot, which is immutable.
+// Writers are serialized by mu and publish a new snapshot built from all
+// collected messages (copy-on-write).
+type registry struct {
+	mu           sync.Mutex
+	translations *translations
+	current      atomic.Pointer[snapshot]
+}
+
+// snapshot is an immutable view of the registry used for formatting
+type snapshot struct {
+	bundle  *i18n.Bundle
+	locales []string
+	matcher language.Matcher
+	tables  map[string]*localeNames
+
+	// Cache of resolved locale strings. The map is replaced, never
+	// modified, so readers can use it without locking.
+	cache   atomic.Pointer[map[string]*localeData]
+	cacheMu sync.Mutex
+}
+
+// The registry used by all formatting functions. It is initialized during
+// package variable initialization, which runs before any init function and
+// after the embedded translations it depends on.
+var defaultRegistry = newDefaultRegistry()
+
+// newDefaultRegistry loads the built-in translations, followed by the
+// translation files on disk and the embedded translations, which override
+// the built-in ones
+func newDefaultRegistry() *registry {
+	t := newTranslations()
+	addBuiltinTranslations(t)
+	loadTranslationFiles(t)
+	loadEmbeddedTranslations(t)
+
+	s, err := newSnapshot(t)
+	if err != nil {
+		panic(err)
+	}
+	r := &registry{translations: t}
+	r.current.Store(s)
+	return r
+}
+
+// register adds messages for a locale and publishes a new snapshot. If the
+// snapshot cannot be built, the registry is left as it was.
+func (r *registry) register(locale string, messages []*i18n.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t := r.translations.clone()
+	t.add(locale, messages...)
+	s, err := newSnapshot(t)
+	if err != nil {
+		return err
+	}
+	r.translations = t
+	r.current.Store(s)
+	return nil
+}
+
+// newSnapshot builds a bundle, matcher and name tables from the collected translations
+func newSnapshot(t *translations) (*snapshot, error) {
+	s := &snapshot{
+		bundle:  i18n.NewBundle(language.English),
+		locales: append([]string(nil), t.locales...),
+		tables:  make(map[string]*localeNames, len(t.locales)),
+	}
+
+	for _, locale := range s.locales {
+		if err := s.bundle.AddMessages(language.MustParse(locale), t.messages[locale]...); err != nil {
+			return nil, err
+		}
+	}
+	s.matcher = newLocaleMatcher(s.locales)
+
+	// Precompute the month and weekday names used by the formatter. Missing
+	// messages fall back along the locale's fallback chain, then to English.
+	for _, locale := range s.locales {
+		s.tables[locale] = buildLocaleNames(i18n.NewLocalizer(s.bundle, fallbackChain(language.MustParse(locale))...))
+	}
+
+	empty := make(map[string]*localeData)
+	s.cache.Store(&empty)
+	return s, nil
+}
+
+// currentSnapshot returns the snapshot of the default registry
+func currentSnapshot() *snapshot {
+	return defaultRegistry.current.Load()
+}
+
+// isSupported reports whether translations exist for exactly this locale
+func (s *snapshot) isSupported(locale string) bool {
+	_, ok := s.tables[locale]
+	return ok
+}
+
+// RegisterLocale adds translations for a locale, given as the contents of a
+// go-i18n JSON message file in the same format as the files in i18n/locales.
+// Messages missing from the file fall back to the locale's parent (for
+// example "fr" for "fr-CA") and then to English. Registering a locale again
+// overrides the previously registered messages.
+//
+// RegisterLocale is safe to call concurrently with formatting.
+func RegisterLocale(locale string, data []byte) error {
+	if locale == "" {
+		return errors.New("littledate: empty locale")
+	}
+	tag, err := parseLocale(locale)
+	if err != nil {
+		return fmt.Errorf("littledate: invalid locale %q: %w", locale, err)
+	}
+
+	messages, err := parseMessageFile(data, tag.String()+".json")
+	if err != nil {
+		return fmt.Errorf("littledate: invalid translations for %q: %w", locale, err)
+	}
+
+	if err := defaultRegistry.register(tag.String(), messages); err != nil {
+		return fmt.Errorf("littledate: cannot register %q: %w", locale, err)
+	}
+	return nil
+}
+
+// Locales returns the locales that have their own translations
+func Locales() []string {
+	return append([]string(nil), currentSnapshot().locales...)
+}
+
+// parseMessageFile parses the contents of a go-i18n message file
+func parseMessageFile(data []byte, path string) ([]*i18n.Message, error) {
+	file, err := i18n.ParseMessageFileBytes(data, path, map[string]i18n.UnmarshalFunc{
+		"json": json.Unmarshal,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return file.Messages, nil
+}
@@ -0,0 +1,146 @@
+package littledate
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRegisterLocale(t *testing.T) {
+	err := RegisterLocale("nl", []byte(`{
+  "month.long.1": {
+    "description": "Full name of January",
+    "other": "januari"
+  },
+  "month.short.1": {
+    "description": "Short name of January",
+    "other": "jan"
+  }
+}`))
+	if err != nil {
+		t.Fatalf("RegisterLocale() error = %v", err)
+	}
+
+	if match := MatchLocale("nl-BE"); match.Locale != "nl" {
+		t.Errorf("MatchLocale(nl-BE) = %s, want nl", match.Locale)
+	}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		expected string
+	}{
+		{
+			name:     "registered translation",
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 31, 23, 59, 59, 999999999, time.UTC),
+			expected: "januari 2023",
+		},
+		{
+			name:     "missing translation falls back to English",
+			from:     time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 2, 28, 23, 59, 59, 999999999, time.UTC),
+			expected: "February 2023",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := DateRangeFormatOptions{Today: today, Locale: "nl_NL"}
+			if result := FormatDateRange(tt.from, tt.to, options); result != tt.expected {
+				t.Errorf("FormatDateRange() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegisterLocaleErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale string
+		data   string
+	}{
+		{name: "empty locale", locale: "", data: `{}`},
+		{name: "invalid locale", locale: "not a locale", data: `{}`},
+		{name: "invalid JSON", locale: "sv", data: `{"month.long.1": `},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := RegisterLocale(tt.locale, []byte(tt.data)); err == nil {
+				t.Errorf("RegisterLocale(%q) error = nil, want an error", tt.locale)
+			}
+		})
+	}
+}
+
+func TestRegisterLocaleWithoutPluralRules(t *testing.T) {
+	month := `{"month.long.1": {"description": "Full name of January", "other": %q}}`
+
+	// Klingon has no plural rules, so its messages cannot be added to a bundle
+	if err := RegisterLocale("tlh", []byte(fmt.Sprintf(month, "jar wa'"))); err == nil {
+		t.Fatalf("RegisterLocale(tlh) error = nil, want an error")
+	}
+	if MatchLocale("tlh").Locale == "tlh" {
+		t.Errorf("MatchLocale(tlh) = tlh, want the failed registration to be discarded")
+	}
+
+	// The registry keeps working after the failed registration
+	if err := RegisterLocale("pt", []byte(fmt.Sprintf(month, "janeiro"))); err != nil {
+		t.Fatalf("RegisterLocale(pt) error = %v", err)
+	}
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 1, 31, 23, 59, 59, 999999999, time.UTC)
+	if result := FormatDateRange(from, to, DateRangeFormatOptions{Today: today, Locale: "pt"}); result != "janeiro 2023" {
+		t.Errorf("FormatDateRange() = %v, want janeiro 2023", result)
+	}
+}
+
+// Run with -race to check that formatting and registering can happen concurrently
+func TestRegistryConcurrency(t *testing.T) {
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 1, 31, 23, 59, 59, 999999999, time.UTC)
+	valid := map[string]bool{"January 2023": true, "januar 2023": true, "Januari 2023": true}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := "januar"
+			if i%2 == 1 {
+				name = "Januari"
+			}
+			for j := 0; j < 20; j++ {
+				err := RegisterLocale("da", []byte(`{"month.long.1": {"other": "`+name+`"}}`))
+				if err != nil {
+					t.Errorf("RegisterLocale() error = %v", err)
+					return
+				}
+			}
+		}(i)
+	}
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				for _, locale := range []string{"da", "da_DK", "en_US", "zh-Hant-HK"} {
+					options := DateRangeFormatOptions{Today: today, Locale: locale}
+					result := FormatDateRange(from, to, options)
+					if locale != "zh-Hant-HK" && !valid[result] {
+						t.Errorf("FormatDateRange(%s) = %v, want one of %v", locale, result, valid)
+						return
+					}
+				}
+				MatchLocale("da")
+				Locales()
+			}
+		}()
+	}
+
+	wg.Wait()
+}
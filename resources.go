@@ -3,5 +3,6 @@
 
 package littledate
 
-// This file is empty by default
-// When building with -tags=embed_resources, it will be replaced by a file containing embedded resources
+// No translations are embedded by default
+// When building with -tags=embed_resources, resources_embed.go provides them instead
+var embeddedTranslations map[string]string
@@ -1,85 +1,14 @@
+// Code generated by go run ./internal/genlocales; DO NOT EDIT.
+
 //go:build embed_resources
 // +build embed_resources
 
 package littledate
 
-import (
-	"bytes"
-)
-
-// Init function will be called after package-level variable initialization
-// It will load the embedded translations
-func init() {
-	// Add these translators after the normal init() has run
-	// The order is important because we need the bundle to be initialized first
-	registerEmbeddedTranslations()
-}
-
-// Register embedded translations
-func registerEmbeddedTranslations() {
-	// English
-	if err := loadEmbeddedTranslation("en", englishTranslation); err == nil {
-		// Successfully loaded
-	}
-
-	// French
-	if err := loadEmbeddedTranslation("fr", frenchTranslation); err == nil {
-		// Successfully loaded
-	}
-
-	// Spanish
-	if err := loadEmbeddedTranslation("es", spanishTranslation); err == nil {
-		// Successfully loaded
-	}
-
-	// German
-	if err := loadEmbeddedTranslation("de", germanTranslation); err == nil {
-		// Successfully loaded
-	}
-
-	// Japanese
-	if err := loadEmbeddedTranslation("ja", japaneseTranslation); err == nil {
-		// Successfully loaded
-	}
-
-	// Korean
-	if err := loadEmbeddedTranslation("ko", koreanTranslation); err == nil {
-		// Successfully loaded
-	}
-
-	// Chinese Simplified
-	if err := loadEmbeddedTranslation("zh-CN", chineseSimplifiedTranslation); err == nil {
-		// Successfully loaded
-	}
-
-	// Chinese Traditional
-	if err := loadEmbeddedTranslation("zh-TW", chineseTraditionalTranslation); err == nil {
-		// Successfully loaded
-	}
-
-	// Vietnamese
-	if err := loadEmbeddedTranslation("vi", vietnameseTranslation); err == nil {
-		// Successfully loaded
-	}
-}
-
-// Load a translation from an embedded string
-func loadEmbeddedTranslation(locale string, translation string) error {
-	// Only load if the bundle exists
-	if bundle == nil {
-		return nil
-	}
-
-	// Create a reader from the string
-	reader := bytes.NewReader([]byte(translation))
-
-	// Parse the JSON
-	_, err := bundle.ParseMessageFileBytes(reader.Bytes(), locale+".json")
-	return err
-}
-
-// Embedded translations as strings
-var englishTranslation = `{
+// Translations embedded into the binary, keyed by locale.
+// They are loaded by newDefaultRegistry after the built-in and on-disk translations.
+var embeddedTranslations = map[string]string{
+	"en": `{
   "month.long.1": {
     "description": "Full name of January",
     "other": "January"
@@ -231,320 +160,1736 @@ var englishTranslation = `{
   "weekday.short.6": {
     "description": "Short name of Saturday",
     "other": "Sat"
-  }
-}`
-
-var frenchTranslation = `{
-  "month.long.1": {
-    "description": "Full name of January",
-    "other": "Janvier"
   },
-  "month.long.2": {
-    "description": "Full name of February",
-    "other": "Février"
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "now"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "one": "{{.Count}} minute ago",
+    "other": "{{.Count}} minutes ago"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "one": "{{.Count}} hour ago",
+    "other": "{{.Count}} hours ago"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "one": "{{.Count}} day ago",
+    "other": "{{.Count}} days ago"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "one": "{{.Count}} week ago",
+    "other": "{{.Count}} weeks ago"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "one": "{{.Count}} month ago",
+    "other": "{{.Count}} months ago"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "one": "{{.Count}} year ago",
+    "other": "{{.Count}} years ago"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "one": "in {{.Count}} minute",
+    "other": "in {{.Count}} minutes"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "one": "in {{.Count}} hour",
+    "other": "in {{.Count}} hours"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "one": "in {{.Count}} day",
+    "other": "in {{.Count}} days"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "one": "in {{.Count}} week",
+    "other": "in {{.Count}} weeks"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "one": "in {{.Count}} month",
+    "other": "in {{.Count}} months"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "one": "in {{.Count}} year",
+    "other": "in {{.Count}} years"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "one": "{{.Count}} day",
+    "other": "{{.Count}} days"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "one": "{{.Count}} hour",
+    "other": "{{.Count}} hours"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "one": "{{.Count}} minute",
+    "other": "{{.Count}} minutes"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "one": "{{.Count}} second",
+    "other": "{{.Count}} seconds"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}} {{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}, {{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}} and {{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": ", "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "{{.From}} to {{.To}}"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "Quarter {{.Quarter}}, {{.Year}}"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{.Month}} {{.Day}}{{if .Year}}, {{.Year}}{{end}}"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Weekday}}, {{.Date}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "{{.Time}} AM"
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "{{.Time}} PM"
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "Daily"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "Every {{.Count}} days"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "Weekly"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "Every {{.Count}} weeks"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "Monthly"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "Every {{.Count}} months"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "Yearly"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "Every {{.Count}} years"
   },
-  "month.long.3": {
-    "description": "Full name of March",
-    "other": "Mars"
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "Every {{.Days}}"
   },
-  "month.long.4": {
-    "description": "Full name of April",
-    "other": "Avril"
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "Every {{.Count}} weeks on {{.Days}}"
   },
-  "month.long.5": {
-    "description": "Full name of May",
-    "other": "Mai"
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}} on {{.Days}}"
   },
-  "month.long.6": {
-    "description": "Full name of June",
-    "other": "Juin"
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "day {{.Day}}"
   },
-  "month.long.7": {
-    "description": "Full name of July",
-    "other": "Juillet"
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "the last day"
   },
-  "month.long.8": {
-    "description": "Full name of August",
-    "other": "Août"
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "the {{.Nth}} {{.Weekday}}"
   },
-  "month.long.9": {
-    "description": "Full name of September",
-    "other": "Septembre"
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "1st"
   },
-  "month.long.10": {
-    "description": "Full name of October",
-    "other": "Octobre"
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "2nd"
   },
-  "month.long.11": {
-    "description": "Full name of November",
-    "other": "Novembre"
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "3rd"
   },
-  "month.long.12": {
-    "description": "Full name of December",
-    "other": "Décembre"
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "4th"
   },
-  "month.short.1": {
-    "description": "Short name of January",
-    "other": "Jan"
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "5th"
   },
-  "month.short.2": {
-    "description": "Short name of February",
-    "other": "Fév"
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "last"
   },
-  "month.short.3": {
-    "description": "Short name of March",
-    "other": "Mar"
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "once"
   },
-  "month.short.4": {
-    "description": "Short name of April",
-    "other": "Avr"
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "other": "{{.Count}} times"
   },
-  "month.short.5": {
-    "description": "Short name of May",
-    "other": "Mai"
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "Muharram"
   },
-  "month.short.6": {
-    "description": "Short name of June",
-    "other": "Jun"
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "Safar"
   },
-  "month.short.7": {
-    "description": "Short name of July",
-    "other": "Jul"
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "Rabiʻ I"
   },
-  "month.short.8": {
-    "description": "Short name of August",
-    "other": "Aoû"
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "Rabiʻ II"
   },
-  "month.short.9": {
-    "description": "Short name of September",
-    "other": "Sep"
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "Jumada I"
   },
-  "month.short.10": {
-    "description": "Short name of October",
-    "other": "Oct"
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "Jumada II"
   },
-  "month.short.11": {
-    "description": "Short name of November",
-    "other": "Nov"
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "Rajab"
   },
-  "month.short.12": {
-    "description": "Short name of December",
-    "other": "Déc"
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "Shaʻban"
   },
-  "weekday.long.0": {
-    "description": "Full name of Sunday",
-    "other": "Dimanche"
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "Ramadan"
   },
-  "weekday.long.1": {
-    "description": "Full name of Monday",
-    "other": "Lundi"
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "Shawwal"
   },
-  "weekday.long.2": {
-    "description": "Full name of Tuesday",
-    "other": "Mardi"
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "Dhuʻl-Qiʻdah"
   },
-  "weekday.long.3": {
-    "description": "Full name of Wednesday",
-    "other": "Mercredi"
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "Dhuʻl-Hijjah"
   },
-  "weekday.long.4": {
-    "description": "Full name of Thursday",
-    "other": "Jeudi"
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "Muh."
   },
-  "weekday.long.5": {
-    "description": "Full name of Friday",
-    "other": "Vendredi"
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "Saf."
   },
-  "weekday.long.6": {
-    "description": "Full name of Saturday",
-    "other": "Samedi"
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "Rab. I"
   },
-  "weekday.short.0": {
-    "description": "Short name of Sunday",
-    "other": "Dim"
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "Rab. II"
   },
-  "weekday.short.1": {
-    "description": "Short name of Monday",
-    "other": "Lun"
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "Jum. I"
   },
-  "weekday.short.2": {
-    "description": "Short name of Tuesday",
-    "other": "Mar"
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "Jum. II"
   },
-  "weekday.short.3": {
-    "description": "Short name of Wednesday",
-    "other": "Mer"
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "Raj."
   },
-  "weekday.short.4": {
-    "description": "Short name of Thursday",
-    "other": "Jeu"
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "Sha."
   },
-  "weekday.short.5": {
-    "description": "Short name of Friday",
-    "other": "Ven"
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "Ram."
   },
-  "weekday.short.6": {
-    "description": "Short name of Saturday",
-    "other": "Sam"
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "Shaw."
+  },
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "Dhuʻl-Q."
+  },
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "Dhuʻl-H."
+  },
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "Farvardin"
+  },
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "Ordibehesht"
+  },
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "Khordad"
+  },
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "Tir"
+  },
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "Mordad"
+  },
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "Shahrivar"
+  },
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "Mehr"
+  },
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "Aban"
+  },
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "Azar"
+  },
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "Dey"
+  },
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "Bahman"
+  },
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "Esfand"
+  },
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "Farvardin"
+  },
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "Ordibehesht"
+  },
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "Khordad"
+  },
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "Tir"
+  },
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "Mordad"
+  },
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "Shahrivar"
+  },
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "Mehr"
+  },
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "Aban"
+  },
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "Azar"
+  },
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "Dey"
+  },
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "Bahman"
+  },
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "Esfand"
+  },
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "Tishrei"
+  },
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "Heshvan"
+  },
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "Kislev"
+  },
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "Tevet"
+  },
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "Shevat"
+  },
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "Adar I"
+  },
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "Adar"
+  },
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "Adar II"
+  },
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "Nisan"
+  },
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "Iyar"
+  },
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "Sivan"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "Tamuz"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "Av"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "Elul"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "Tishrei"
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "Heshvan"
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "Kislev"
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "Tevet"
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "Shevat"
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "Adar I"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "Adar"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "Adar II"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "Nisan"
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "Iyar"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "Sivan"
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "Tamuz"
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "Av"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "Elul"
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "First Month"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Second Month"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Third Month"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Fourth Month"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Fifth Month"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Sixth Month"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Seventh Month"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Eighth Month"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Ninth Month"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Tenth Month"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Eleventh Month"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Twelfth Month"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mo1"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mo2"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mo3"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mo4"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mo5"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mo6"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mo7"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mo8"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mo9"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mo10"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mo11"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mo12"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "Leap {{.Month}}"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "13"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "14"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "15"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "16"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "17"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "18"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "19"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "20"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "21"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "22"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "23"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "24"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "25"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "26"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "27"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "28"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "29"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "30"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Day}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Month}} {{.Day}}"
   }
-}`
-
-var spanishTranslation = `{
+}`,
+	"ar": `{
   "month.long.1": {
     "description": "Full name of January",
-    "other": "Enero"
+    "other": "يناير"
   },
   "month.long.2": {
     "description": "Full name of February",
-    "other": "Febrero"
+    "other": "فبراير"
   },
   "month.long.3": {
     "description": "Full name of March",
-    "other": "Marzo"
+    "other": "مارس"
   },
   "month.long.4": {
     "description": "Full name of April",
-    "other": "Abril"
+    "other": "أبريل"
   },
   "month.long.5": {
     "description": "Full name of May",
-    "other": "Mayo"
+    "other": "مايو"
   },
   "month.long.6": {
     "description": "Full name of June",
-    "other": "Junio"
+    "other": "يونيو"
   },
   "month.long.7": {
     "description": "Full name of July",
-    "other": "Julio"
+    "other": "يوليو"
   },
   "month.long.8": {
     "description": "Full name of August",
-    "other": "Agosto"
+    "other": "أغسطس"
   },
   "month.long.9": {
     "description": "Full name of September",
-    "other": "Septiembre"
+    "other": "سبتمبر"
   },
   "month.long.10": {
     "description": "Full name of October",
-    "other": "Octubre"
+    "other": "أكتوبر"
   },
   "month.long.11": {
     "description": "Full name of November",
-    "other": "Noviembre"
+    "other": "نوفمبر"
   },
   "month.long.12": {
     "description": "Full name of December",
-    "other": "Diciembre"
+    "other": "ديسمبر"
   },
   "month.short.1": {
     "description": "Short name of January",
-    "other": "Ene"
+    "other": "يناير"
   },
   "month.short.2": {
     "description": "Short name of February",
-    "other": "Feb"
+    "other": "فبراير"
   },
   "month.short.3": {
     "description": "Short name of March",
-    "other": "Mar"
+    "other": "مارس"
   },
   "month.short.4": {
     "description": "Short name of April",
-    "other": "Abr"
+    "other": "أبريل"
   },
   "month.short.5": {
     "description": "Short name of May",
-    "other": "May"
+    "other": "مايو"
   },
   "month.short.6": {
     "description": "Short name of June",
-    "other": "Jun"
+    "other": "يونيو"
   },
   "month.short.7": {
     "description": "Short name of July",
-    "other": "Jul"
+    "other": "يوليو"
   },
   "month.short.8": {
     "description": "Short name of August",
-    "other": "Ago"
+    "other": "أغسطس"
   },
   "month.short.9": {
     "description": "Short name of September",
-    "other": "Sep"
+    "other": "سبتمبر"
   },
   "month.short.10": {
     "description": "Short name of October",
-    "other": "Oct"
+    "other": "أكتوبر"
   },
   "month.short.11": {
     "description": "Short name of November",
-    "other": "Nov"
+    "other": "نوفمبر"
   },
   "month.short.12": {
     "description": "Short name of December",
-    "other": "Dic"
+    "other": "ديسمبر"
   },
   "weekday.long.0": {
     "description": "Full name of Sunday",
-    "other": "Domingo"
+    "other": "الأحد"
   },
   "weekday.long.1": {
     "description": "Full name of Monday",
-    "other": "Lunes"
+    "other": "الاثنين"
   },
   "weekday.long.2": {
     "description": "Full name of Tuesday",
-    "other": "Martes"
+    "other": "الثلاثاء"
   },
   "weekday.long.3": {
     "description": "Full name of Wednesday",
-    "other": "Miércoles"
+    "other": "الأربعاء"
   },
   "weekday.long.4": {
     "description": "Full name of Thursday",
-    "other": "Jueves"
+    "other": "الخميس"
   },
   "weekday.long.5": {
     "description": "Full name of Friday",
-    "other": "Viernes"
+    "other": "الجمعة"
   },
   "weekday.long.6": {
     "description": "Full name of Saturday",
-    "other": "Sábado"
+    "other": "السبت"
   },
   "weekday.short.0": {
     "description": "Short name of Sunday",
-    "other": "Dom"
+    "other": "الأحد"
   },
   "weekday.short.1": {
     "description": "Short name of Monday",
-    "other": "Lun"
+    "other": "الاثنين"
   },
   "weekday.short.2": {
     "description": "Short name of Tuesday",
-    "other": "Mar"
+    "other": "الثلاثاء"
   },
   "weekday.short.3": {
     "description": "Short name of Wednesday",
-    "other": "Mié"
+    "other": "الأربعاء"
   },
   "weekday.short.4": {
     "description": "Short name of Thursday",
-    "other": "Jue"
+    "other": "الخميس"
   },
   "weekday.short.5": {
     "description": "Short name of Friday",
-    "other": "Vie"
+    "other": "الجمعة"
   },
   "weekday.short.6": {
     "description": "Short name of Saturday",
-    "other": "Sáb"
+    "other": "السبت"
+  },
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "الآن"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "zero": "قبل {{.Count}} دقيقة",
+    "one": "قبل دقيقة واحدة",
+    "two": "قبل دقيقتين",
+    "few": "قبل {{.Count}} دقائق",
+    "many": "قبل {{.Count}} دقيقة",
+    "other": "قبل {{.Count}} دقيقة"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "zero": "قبل {{.Count}} ساعة",
+    "one": "قبل ساعة واحدة",
+    "two": "قبل ساعتين",
+    "few": "قبل {{.Count}} ساعات",
+    "many": "قبل {{.Count}} ساعة",
+    "other": "قبل {{.Count}} ساعة"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "zero": "قبل {{.Count}} يوم",
+    "one": "قبل يوم واحد",
+    "two": "قبل يومين",
+    "few": "قبل {{.Count}} أيام",
+    "many": "قبل {{.Count}} يوم",
+    "other": "قبل {{.Count}} يوم"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "zero": "قبل {{.Count}} أسبوع",
+    "one": "قبل أسبوع واحد",
+    "two": "قبل أسبوعين",
+    "few": "قبل {{.Count}} أسابيع",
+    "many": "قبل {{.Count}} أسبوع",
+    "other": "قبل {{.Count}} أسبوع"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "zero": "قبل {{.Count}} شهر",
+    "one": "قبل شهر واحد",
+    "two": "قبل شهرين",
+    "few": "قبل {{.Count}} أشهر",
+    "many": "قبل {{.Count}} شهر",
+    "other": "قبل {{.Count}} شهر"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "zero": "قبل {{.Count}} سنة",
+    "one": "قبل سنة واحدة",
+    "two": "قبل سنتين",
+    "few": "قبل {{.Count}} سنوات",
+    "many": "قبل {{.Count}} سنة",
+    "other": "قبل {{.Count}} سنة"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "zero": "خلال {{.Count}} دقيقة",
+    "one": "خلال دقيقة واحدة",
+    "two": "خلال دقيقتين",
+    "few": "خلال {{.Count}} دقائق",
+    "many": "خلال {{.Count}} دقيقة",
+    "other": "خلال {{.Count}} دقيقة"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "zero": "خلال {{.Count}} ساعة",
+    "one": "خلال ساعة واحدة",
+    "two": "خلال ساعتين",
+    "few": "خلال {{.Count}} ساعات",
+    "many": "خلال {{.Count}} ساعة",
+    "other": "خلال {{.Count}} ساعة"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "zero": "خلال {{.Count}} يوم",
+    "one": "خلال يوم واحد",
+    "two": "خلال يومين",
+    "few": "خلال {{.Count}} أيام",
+    "many": "خلال {{.Count}} يوم",
+    "other": "خلال {{.Count}} يوم"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "zero": "خلال {{.Count}} أسبوع",
+    "one": "خلال أسبوع واحد",
+    "two": "خلال أسبوعين",
+    "few": "خلال {{.Count}} أسابيع",
+    "many": "خلال {{.Count}} أسبوع",
+    "other": "خلال {{.Count}} أسبوع"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "zero": "خلال {{.Count}} شهر",
+    "one": "خلال شهر واحد",
+    "two": "خلال شهرين",
+    "few": "خلال {{.Count}} أشهر",
+    "many": "خلال {{.Count}} شهر",
+    "other": "خلال {{.Count}} شهر"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "zero": "خلال {{.Count}} سنة",
+    "one": "خلال سنة واحدة",
+    "two": "خلال سنتين",
+    "few": "خلال {{.Count}} سنوات",
+    "many": "خلال {{.Count}} سنة",
+    "other": "خلال {{.Count}} سنة"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "zero": "{{.Count}} يوم",
+    "one": "يوم",
+    "two": "يومان",
+    "few": "{{.Count}} أيام",
+    "many": "{{.Count}} يوم",
+    "other": "{{.Count}} يوم"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "zero": "{{.Count}} ساعة",
+    "one": "ساعة",
+    "two": "ساعتان",
+    "few": "{{.Count}} ساعات",
+    "many": "{{.Count}} ساعة",
+    "other": "{{.Count}} ساعة"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "zero": "{{.Count}} دقيقة",
+    "one": "دقيقة",
+    "two": "دقيقتان",
+    "few": "{{.Count}} دقائق",
+    "many": "{{.Count}} دقيقة",
+    "other": "{{.Count}} دقيقة"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "zero": "{{.Count}} ثانية",
+    "one": "ثانية",
+    "two": "ثانيتان",
+    "few": "{{.Count}} ثوانٍ",
+    "many": "{{.Count}} ثانية",
+    "other": "{{.Count}} ثانية"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}} و{{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}، {{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}} و{{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": "، "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "من {{.From}} إلى {{.To}}"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "الربع {{.Quarter}} من {{.Year}}"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{.Day}} {{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Weekday}}، {{.Date}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "{{.Time}} ص"
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "{{.Time}} م"
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "يوميًا"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "كل {{.Count}} يوم"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "أسبوعيًا"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "كل {{.Count}} أسبوع"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "شهريًا"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "كل {{.Count}} شهر"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "سنويًا"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "كل {{.Count}} سنة"
+  },
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "كل {{.Days}}"
+  },
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "كل {{.Count}} أسبوع يوم {{.Days}}"
+  },
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}} في {{.Days}}"
+  },
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "اليوم {{.Day}}"
+  },
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "اليوم الأخير"
+  },
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "{{.Weekday}} {{.Nth}}"
+  },
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "الأول"
+  },
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "الثاني"
+  },
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "الثالث"
+  },
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "الرابع"
+  },
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "الخامس"
+  },
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "الأخير"
+  },
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "مرة واحدة"
+  },
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "zero": "{{.Count}} مرة",
+    "one": "مرة",
+    "two": "مرتين",
+    "few": "{{.Count}} مرات",
+    "many": "{{.Count}} مرة",
+    "other": "{{.Count}} مرة"
+  },
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "محرم"
+  },
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "صفر"
+  },
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "ربيع الأول"
+  },
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "ربيع الآخر"
+  },
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "جمادى الأولى"
+  },
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "جمادى الآخرة"
+  },
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "رجب"
+  },
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "شعبان"
+  },
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "رمضان"
+  },
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "شوال"
+  },
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "ذو القعدة"
+  },
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "ذو الحجة"
+  },
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "محرم"
+  },
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "صفر"
+  },
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "ربيع الأول"
+  },
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "ربيع الآخر"
+  },
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "جمادى الأولى"
+  },
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "جمادى الآخرة"
+  },
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "رجب"
+  },
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "شعبان"
+  },
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "رمضان"
+  },
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "شوال"
+  },
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "ذو القعدة"
+  },
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "ذو الحجة"
+  },
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "فرفردن"
+  },
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "أذربيهشت"
+  },
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "خرداد"
+  },
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "تار"
+  },
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "مرداد"
+  },
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "شهرفار"
+  },
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "مهر"
+  },
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "آيان"
+  },
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "آذر"
+  },
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "دي"
+  },
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "بهمن"
+  },
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "اسفندار"
+  },
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "فرفردن"
+  },
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "أذربيهشت"
+  },
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "خرداد"
+  },
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "تار"
+  },
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "مرداد"
+  },
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "شهرفار"
+  },
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "مهر"
+  },
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "آيان"
+  },
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "آذر"
+  },
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "دي"
+  },
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "بهمن"
+  },
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "اسفندار"
+  },
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "تشري"
+  },
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "مرحشوان"
+  },
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "كيسلو"
+  },
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "طيفت"
+  },
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "شباط"
+  },
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "آذار الأول"
+  },
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "آذار"
+  },
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "آذار الثاني"
+  },
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "نيسان"
+  },
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "أيار"
+  },
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "سيفان"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "تموز"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "آب"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "أيلول"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "تشري"
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "مرحشوان"
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "كيسلو"
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "طيفت"
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "شباط"
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "آذار الأول"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "آذار"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "آذار الثاني"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "نيسان"
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "أيار"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "سيفان"
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "تموز"
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "آب"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "أيلول"
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M01"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M02"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M03"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M04"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M05"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M06"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M07"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M08"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M09"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M10"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M11"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M12"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M01"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M02"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M03"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M04"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M05"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M06"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M07"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M08"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M09"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M10"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M11"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M12"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "{{.Month}}bis"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "13"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "14"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "15"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "16"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "17"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "18"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "19"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "20"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "21"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "22"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "23"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "24"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "25"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "26"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "27"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "28"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "29"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "30"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Day}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Month}} {{.Day}}"
   }
-}`
-
-var germanTranslation = `{
+}`,
+	"de": `{
   "month.long.1": {
     "description": "Full name of January",
     "other": "Januar"
@@ -696,780 +2041,10663 @@ var germanTranslation = `{
   "weekday.short.6": {
     "description": "Short name of Saturday",
     "other": "Sa"
-  }
-}`
-
-var japaneseTranslation = `{
-  "month.long.1": {
-    "description": "Full name of January",
-    "other": "1月"
   },
-  "month.long.2": {
-    "description": "Full name of February",
-    "other": "2月"
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "jetzt"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "one": "vor {{.Count}} Minute",
+    "other": "vor {{.Count}} Minuten"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "one": "vor {{.Count}} Stunde",
+    "other": "vor {{.Count}} Stunden"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "one": "vor {{.Count}} Tag",
+    "other": "vor {{.Count}} Tagen"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "one": "vor {{.Count}} Woche",
+    "other": "vor {{.Count}} Wochen"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "one": "vor {{.Count}} Monat",
+    "other": "vor {{.Count}} Monaten"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "one": "vor {{.Count}} Jahr",
+    "other": "vor {{.Count}} Jahren"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "one": "in {{.Count}} Minute",
+    "other": "in {{.Count}} Minuten"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "one": "in {{.Count}} Stunde",
+    "other": "in {{.Count}} Stunden"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "one": "in {{.Count}} Tag",
+    "other": "in {{.Count}} Tagen"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "one": "in {{.Count}} Woche",
+    "other": "in {{.Count}} Wochen"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "one": "in {{.Count}} Monat",
+    "other": "in {{.Count}} Monaten"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "one": "in {{.Count}} Jahr",
+    "other": "in {{.Count}} Jahren"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "one": "{{.Count}} Tag",
+    "other": "{{.Count}} Tage"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "one": "{{.Count}} Stunde",
+    "other": "{{.Count}} Stunden"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "one": "{{.Count}} Minute",
+    "other": "{{.Count}} Minuten"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "one": "{{.Count}} Sekunde",
+    "other": "{{.Count}} Sekunden"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}} {{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}, {{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}} und {{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": ", "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "{{.From}} bis {{.To}}"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "{{.Quarter}}. Quartal {{.Year}}"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{.Day}}. {{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Weekday}}, {{.Date}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "{{.Time}} AM"
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "{{.Time}} PM"
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "Täglich"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "Alle {{.Count}} Tage"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "Wöchentlich"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "Alle {{.Count}} Wochen"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "Monatlich"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "Alle {{.Count}} Monate"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "Jährlich"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "Alle {{.Count}} Jahre"
   },
-  "month.long.3": {
-    "description": "Full name of March",
-    "other": "3月"
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "Jeden {{.Days}}"
   },
-  "month.long.4": {
-    "description": "Full name of April",
-    "other": "4月"
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "Alle {{.Count}} Wochen am {{.Days}}"
   },
-  "month.long.5": {
-    "description": "Full name of May",
-    "other": "5月"
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}} am {{.Days}}"
   },
-  "month.long.6": {
-    "description": "Full name of June",
-    "other": "6月"
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "{{.Day}}."
   },
-  "month.long.7": {
-    "description": "Full name of July",
-    "other": "7月"
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "letzten Tag"
   },
-  "month.long.8": {
-    "description": "Full name of August",
-    "other": "8月"
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "{{.Nth}} {{.Weekday}}"
   },
-  "month.long.9": {
-    "description": "Full name of September",
-    "other": "9月"
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "1."
   },
-  "month.long.10": {
-    "description": "Full name of October",
-    "other": "10月"
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "2."
   },
-  "month.long.11": {
-    "description": "Full name of November",
-    "other": "11月"
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "3."
   },
-  "month.long.12": {
-    "description": "Full name of December",
-    "other": "12月"
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "4."
   },
-  "month.short.1": {
-    "description": "Short name of January",
-    "other": "1月"
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "5."
   },
-  "month.short.2": {
-    "description": "Short name of February",
-    "other": "2月"
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "letzten"
   },
-  "month.short.3": {
-    "description": "Short name of March",
-    "other": "3月"
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "einmal"
   },
-  "month.short.4": {
-    "description": "Short name of April",
-    "other": "4月"
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "other": "{{.Count}}-mal"
   },
-  "month.short.5": {
-    "description": "Short name of May",
-    "other": "5月"
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "Muharram"
   },
-  "month.short.6": {
-    "description": "Short name of June",
-    "other": "6月"
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "Safar"
   },
-  "month.short.7": {
-    "description": "Short name of July",
-    "other": "7月"
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "Rabiʻ I"
   },
-  "month.short.8": {
-    "description": "Short name of August",
-    "other": "8月"
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "Rabiʻ II"
   },
-  "month.short.9": {
-    "description": "Short name of September",
-    "other": "9月"
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "Dschumada I"
   },
-  "month.short.10": {
-    "description": "Short name of October",
-    "other": "10月"
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "Dschumada II"
   },
-  "month.short.11": {
-    "description": "Short name of November",
-    "other": "11月"
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "Radschab"
   },
-  "month.short.12": {
-    "description": "Short name of December",
-    "other": "12月"
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "Shaʻban"
   },
-  "weekday.long.0": {
-    "description": "Full name of Sunday",
-    "other": "日曜日"
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "Ramadan"
   },
-  "weekday.long.1": {
-    "description": "Full name of Monday",
-    "other": "月曜日"
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "Shawwal"
   },
-  "weekday.long.2": {
-    "description": "Full name of Tuesday",
-    "other": "火曜日"
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "Dhu l-qaʿda"
   },
-  "weekday.long.3": {
-    "description": "Full name of Wednesday",
-    "other": "水曜日"
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "Dhu l-Hiddscha"
   },
-  "weekday.long.4": {
-    "description": "Full name of Thursday",
-    "other": "木曜日"
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "Muh."
   },
-  "weekday.long.5": {
-    "description": "Full name of Friday",
-    "other": "金曜日"
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "Saf."
   },
-  "weekday.long.6": {
-    "description": "Full name of Saturday",
-    "other": "土曜日"
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "Rab. I"
   },
-  "weekday.short.0": {
-    "description": "Short name of Sunday",
-    "other": "日"
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "Rab. II"
   },
-  "weekday.short.1": {
-    "description": "Short name of Monday",
-    "other": "月"
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "Jum. I"
   },
-  "weekday.short.2": {
-    "description": "Short name of Tuesday",
-    "other": "火"
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "Jum. II"
   },
-  "weekday.short.3": {
-    "description": "Short name of Wednesday",
-    "other": "水"
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "Raj."
   },
-  "weekday.short.4": {
-    "description": "Short name of Thursday",
-    "other": "木"
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "Sha."
   },
-  "weekday.short.5": {
-    "description": "Short name of Friday",
-    "other": "金"
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "Ram."
   },
-  "weekday.short.6": {
-    "description": "Short name of Saturday",
-    "other": "土"
-  }
-}`
-
-var koreanTranslation = `{
-  "month.long.1": {
-    "description": "Full name of January",
-    "other": "1월"
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "Shaw."
   },
-  "month.long.2": {
-    "description": "Full name of February",
-    "other": "2월"
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "Dhuʻl-Q."
   },
-  "month.long.3": {
-    "description": "Full name of March",
-    "other": "3월"
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "Dhuʻl-H."
   },
-  "month.long.4": {
-    "description": "Full name of April",
-    "other": "4월"
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "Farwardin"
   },
-  "month.long.5": {
-    "description": "Full name of May",
-    "other": "5월"
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "Ordibehescht"
   },
-  "month.long.6": {
-    "description": "Full name of June",
-    "other": "6월"
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "Chordād"
   },
-  "month.long.7": {
-    "description": "Full name of July",
-    "other": "7월"
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "Tir"
   },
-  "month.long.8": {
-    "description": "Full name of August",
-    "other": "8월"
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "Mordād"
   },
-  "month.long.9": {
-    "description": "Full name of September",
-    "other": "9월"
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "Schahriwar"
   },
-  "month.long.10": {
-    "description": "Full name of October",
-    "other": "10월"
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "Mehr"
   },
-  "month.long.11": {
-    "description": "Full name of November",
-    "other": "11월"
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "Ābān"
   },
-  "month.long.12": {
-    "description": "Full name of December",
-    "other": "12월"
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "Āsar"
   },
-  "month.short.1": {
-    "description": "Short name of January",
-    "other": "1월"
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "Déi"
   },
-  "month.short.2": {
-    "description": "Short name of February",
-    "other": "2월"
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "Bahman"
   },
-  "month.short.3": {
-    "description": "Short name of March",
-    "other": "3월"
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "Essfand"
   },
-  "month.short.4": {
-    "description": "Short name of April",
-    "other": "4월"
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "Farwardin"
   },
-  "month.short.5": {
-    "description": "Short name of May",
-    "other": "5월"
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "Ordibehescht"
   },
-  "month.short.6": {
-    "description": "Short name of June",
-    "other": "6월"
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "Chordād"
   },
-  "month.short.7": {
-    "description": "Short name of July",
-    "other": "7월"
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "Tir"
   },
-  "month.short.8": {
-    "description": "Short name of August",
-    "other": "8월"
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "Mordād"
   },
-  "month.short.9": {
-    "description": "Short name of September",
-    "other": "9월"
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "Schahriwar"
   },
-  "month.short.10": {
-    "description": "Short name of October",
-    "other": "10월"
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "Mehr"
   },
-  "month.short.11": {
-    "description": "Short name of November",
-    "other": "11월"
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "Ābān"
   },
-  "month.short.12": {
-    "description": "Short name of December",
-    "other": "12월"
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "Āsar"
   },
-  "weekday.long.0": {
-    "description": "Full name of Sunday",
-    "other": "일요일"
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "Déi"
   },
-  "weekday.long.1": {
-    "description": "Full name of Monday",
-    "other": "월요일"
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "Bahman"
   },
-  "weekday.long.2": {
-    "description": "Full name of Tuesday",
-    "other": "화요일"
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "Essfand"
   },
-  "weekday.long.3": {
-    "description": "Full name of Wednesday",
-    "other": "수요일"
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "Tischri"
   },
-  "weekday.long.4": {
-    "description": "Full name of Thursday",
-    "other": "목요일"
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "Cheschwan"
   },
-  "weekday.long.5": {
-    "description": "Full name of Friday",
-    "other": "금요일"
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "Kislew"
   },
-  "weekday.long.6": {
-    "description": "Full name of Saturday",
-    "other": "토요일"
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "Tevet"
   },
-  "weekday.short.0": {
-    "description": "Short name of Sunday",
-    "other": "일"
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "Schevat"
   },
-  "weekday.short.1": {
-    "description": "Short name of Monday",
-    "other": "월"
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "Adar I"
   },
-  "weekday.short.2": {
-    "description": "Short name of Tuesday",
-    "other": "화"
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "Adar"
   },
-  "weekday.short.3": {
-    "description": "Short name of Wednesday",
-    "other": "수"
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "Adar II"
   },
-  "weekday.short.4": {
-    "description": "Short name of Thursday",
-    "other": "목"
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "Nisan"
   },
-  "weekday.short.5": {
-    "description": "Short name of Friday",
-    "other": "금"
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "Ijjar"
   },
-  "weekday.short.6": {
-    "description": "Short name of Saturday",
-    "other": "토"
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "Siwan"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "Tammus"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "Aw"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "Elul"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "Tischri"
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "Cheschwan"
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "Kislew"
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "Tevet"
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "Schevat"
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "Adar I"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "Adar"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "Adar II"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "Nisan"
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "Ijjar"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "Siwan"
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "Tammus"
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "Aw"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "Elul"
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Erster Monat"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Zweiter Monat"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Dritter Monat"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Vierter Monat"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Fünfter Monat"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Sechster Monat"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Siebter Monat"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Achter Monat"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Neunter Monat"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Zehnter Monat"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Elfter Monat"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Zwölfter Monat"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M01"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M02"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M03"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M04"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M05"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M06"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M07"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M08"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M09"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M10"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M11"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M12"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "{{.Month}} bis"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "13"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "14"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "15"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "16"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "17"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "18"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "19"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "20"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "21"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "22"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "23"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "24"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "25"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "26"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "27"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "28"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "29"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "30"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Day}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Month}} {{.Day}}"
   }
-}`
-
-var chineseSimplifiedTranslation = `{
+}`,
+	"es": `{
   "month.long.1": {
     "description": "Full name of January",
-    "other": "一月"
+    "other": "Enero"
   },
   "month.long.2": {
     "description": "Full name of February",
-    "other": "二月"
+    "other": "Febrero"
   },
   "month.long.3": {
     "description": "Full name of March",
-    "other": "三月"
+    "other": "Marzo"
   },
   "month.long.4": {
     "description": "Full name of April",
-    "other": "四月"
+    "other": "Abril"
   },
   "month.long.5": {
     "description": "Full name of May",
-    "other": "五月"
+    "other": "Mayo"
   },
   "month.long.6": {
     "description": "Full name of June",
-    "other": "六月"
+    "other": "Junio"
   },
   "month.long.7": {
     "description": "Full name of July",
-    "other": "七月"
+    "other": "Julio"
   },
   "month.long.8": {
     "description": "Full name of August",
-    "other": "八月"
+    "other": "Agosto"
   },
   "month.long.9": {
     "description": "Full name of September",
-    "other": "九月"
+    "other": "Septiembre"
   },
   "month.long.10": {
     "description": "Full name of October",
-    "other": "十月"
+    "other": "Octubre"
   },
   "month.long.11": {
     "description": "Full name of November",
-    "other": "十一月"
+    "other": "Noviembre"
   },
   "month.long.12": {
     "description": "Full name of December",
-    "other": "十二月"
+    "other": "Diciembre"
   },
   "month.short.1": {
     "description": "Short name of January",
-    "other": "1月"
+    "other": "Ene"
   },
   "month.short.2": {
     "description": "Short name of February",
-    "other": "2月"
+    "other": "Feb"
   },
   "month.short.3": {
     "description": "Short name of March",
-    "other": "3月"
+    "other": "Mar"
   },
   "month.short.4": {
     "description": "Short name of April",
-    "other": "4月"
+    "other": "Abr"
   },
   "month.short.5": {
     "description": "Short name of May",
-    "other": "5月"
+    "other": "May"
   },
   "month.short.6": {
     "description": "Short name of June",
-    "other": "6月"
+    "other": "Jun"
   },
   "month.short.7": {
     "description": "Short name of July",
-    "other": "7月"
+    "other": "Jul"
   },
   "month.short.8": {
     "description": "Short name of August",
-    "other": "8月"
+    "other": "Ago"
   },
   "month.short.9": {
     "description": "Short name of September",
-    "other": "9月"
+    "other": "Sep"
   },
   "month.short.10": {
     "description": "Short name of October",
-    "other": "10月"
+    "other": "Oct"
   },
   "month.short.11": {
     "description": "Short name of November",
-    "other": "11月"
+    "other": "Nov"
   },
   "month.short.12": {
     "description": "Short name of December",
-    "other": "12月"
+    "other": "Dic"
   },
   "weekday.long.0": {
     "description": "Full name of Sunday",
-    "other": "星期日"
+    "other": "Domingo"
   },
   "weekday.long.1": {
     "description": "Full name of Monday",
-    "other": "星期一"
+    "other": "Lunes"
   },
   "weekday.long.2": {
     "description": "Full name of Tuesday",
-    "other": "星期二"
+    "other": "Martes"
   },
   "weekday.long.3": {
     "description": "Full name of Wednesday",
-    "other": "星期三"
+    "other": "Miércoles"
   },
   "weekday.long.4": {
     "description": "Full name of Thursday",
-    "other": "星期四"
+    "other": "Jueves"
   },
   "weekday.long.5": {
     "description": "Full name of Friday",
-    "other": "星期五"
+    "other": "Viernes"
   },
   "weekday.long.6": {
     "description": "Full name of Saturday",
-    "other": "星期六"
+    "other": "Sábado"
   },
   "weekday.short.0": {
     "description": "Short name of Sunday",
-    "other": "日"
+    "other": "Dom"
   },
   "weekday.short.1": {
     "description": "Short name of Monday",
-    "other": "一"
+    "other": "Lun"
   },
   "weekday.short.2": {
     "description": "Short name of Tuesday",
-    "other": "二"
+    "other": "Mar"
   },
   "weekday.short.3": {
     "description": "Short name of Wednesday",
-    "other": "三"
+    "other": "Mié"
   },
   "weekday.short.4": {
     "description": "Short name of Thursday",
-    "other": "四"
+    "other": "Jue"
   },
   "weekday.short.5": {
     "description": "Short name of Friday",
-    "other": "五"
+    "other": "Vie"
   },
   "weekday.short.6": {
     "description": "Short name of Saturday",
-    "other": "六"
-  }
-}`
-
-var chineseTraditionalTranslation = `{
-  "month.long.1": {
-    "description": "Full name of January",
-    "other": "一月"
+    "other": "Sáb"
   },
-  "month.long.2": {
-    "description": "Full name of February",
-    "other": "二月"
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "ahora"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "one": "hace {{.Count}} minuto",
+    "other": "hace {{.Count}} minutos"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "one": "hace {{.Count}} hora",
+    "other": "hace {{.Count}} horas"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "one": "hace {{.Count}} día",
+    "other": "hace {{.Count}} días"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "one": "hace {{.Count}} semana",
+    "other": "hace {{.Count}} semanas"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "one": "hace {{.Count}} mes",
+    "other": "hace {{.Count}} meses"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "one": "hace {{.Count}} año",
+    "other": "hace {{.Count}} años"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "one": "dentro de {{.Count}} minuto",
+    "other": "dentro de {{.Count}} minutos"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "one": "dentro de {{.Count}} hora",
+    "other": "dentro de {{.Count}} horas"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "one": "dentro de {{.Count}} día",
+    "other": "dentro de {{.Count}} días"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "one": "dentro de {{.Count}} semana",
+    "other": "dentro de {{.Count}} semanas"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "one": "dentro de {{.Count}} mes",
+    "other": "dentro de {{.Count}} meses"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "one": "dentro de {{.Count}} año",
+    "other": "dentro de {{.Count}} años"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "one": "{{.Count}} día",
+    "other": "{{.Count}} días"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "one": "{{.Count}} hora",
+    "other": "{{.Count}} horas"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "one": "{{.Count}} minuto",
+    "other": "{{.Count}} minutos"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "one": "{{.Count}} segundo",
+    "other": "{{.Count}} segundos"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}} {{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}, {{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}} y {{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": ", "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "del {{.From}} al {{.To}}"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "trimestre {{.Quarter}} de {{.Year}}"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{.Day}} de {{.Month}}{{if .Year}} de {{.Year}}{{end}}"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{.Month}}{{if .Year}} de {{.Year}}{{end}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Weekday}}, {{.Date}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "{{.Time}} a. m."
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "{{.Time}} p. m."
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "Diariamente"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "Cada {{.Count}} días"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "Semanalmente"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "Cada {{.Count}} semanas"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "Mensualmente"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "Cada {{.Count}} meses"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "Anualmente"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "Cada {{.Count}} años"
   },
-  "month.long.3": {
-    "description": "Full name of March",
-    "other": "三月"
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "Cada {{.Days}}"
   },
-  "month.long.4": {
-    "description": "Full name of April",
-    "other": "四月"
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "Cada {{.Count}} semanas el {{.Days}}"
   },
-  "month.long.5": {
-    "description": "Full name of May",
-    "other": "五月"
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}} el {{.Days}}"
   },
-  "month.long.6": {
-    "description": "Full name of June",
-    "other": "六月"
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "día {{.Day}}"
   },
-  "month.long.7": {
-    "description": "Full name of July",
-    "other": "七月"
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "último día"
   },
-  "month.long.8": {
-    "description": "Full name of August",
-    "other": "八月"
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "{{.Nth}} {{.Weekday}}"
   },
-  "month.long.9": {
-    "description": "Full name of September",
-    "other": "九月"
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "primer"
   },
-  "month.long.10": {
-    "description": "Full name of October",
-    "other": "十月"
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "segundo"
   },
-  "month.long.11": {
-    "description": "Full name of November",
-    "other": "十一月"
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "tercer"
   },
-  "month.long.12": {
-    "description": "Full name of December",
-    "other": "十二月"
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "cuarto"
   },
-  "month.short.1": {
-    "description": "Short name of January",
-    "other": "1月"
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "quinto"
   },
-  "month.short.2": {
-    "description": "Short name of February",
-    "other": "2月"
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "último"
   },
-  "month.short.3": {
-    "description": "Short name of March",
-    "other": "3月"
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "una vez"
   },
-  "month.short.4": {
-    "description": "Short name of April",
-    "other": "4月"
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "other": "{{.Count}} veces"
   },
-  "month.short.5": {
-    "description": "Short name of May",
-    "other": "5月"
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "muharram"
   },
-  "month.short.6": {
-    "description": "Short name of June",
-    "other": "6月"
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "safar"
   },
-  "month.short.7": {
-    "description": "Short name of July",
-    "other": "7月"
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "rabiʻ I"
   },
-  "month.short.8": {
-    "description": "Short name of August",
-    "other": "8月"
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "rabiʻ II"
   },
-  "month.short.9": {
-    "description": "Short name of September",
-    "other": "9月"
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "jumada I"
   },
-  "month.short.10": {
-    "description": "Short name of October",
-    "other": "10月"
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "jumada II"
   },
-  "month.short.11": {
-    "description": "Short name of November",
-    "other": "11月"
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "rajab"
   },
-  "month.short.12": {
-    "description": "Short name of December",
-    "other": "12月"
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "shaʻban"
   },
-  "weekday.long.0": {
-    "description": "Full name of Sunday",
-    "other": "星期日"
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "ramadán"
   },
-  "weekday.long.1": {
-    "description": "Full name of Monday",
-    "other": "星期一"
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "shawwal"
   },
-  "weekday.long.2": {
-    "description": "Full name of Tuesday",
-    "other": "星期二"
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "dhuʻl-qiʻdah"
   },
-  "weekday.long.3": {
-    "description": "Full name of Wednesday",
-    "other": "星期三"
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "dhuʻl-hijjah"
   },
-  "weekday.long.4": {
-    "description": "Full name of Thursday",
-    "other": "星期四"
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "muh."
   },
-  "weekday.long.5": {
-    "description": "Full name of Friday",
-    "other": "星期五"
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "saf."
   },
-  "weekday.long.6": {
-    "description": "Full name of Saturday",
-    "other": "星期六"
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "rab. I"
   },
-  "weekday.short.0": {
-    "description": "Short name of Sunday",
-    "other": "日"
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "rab. II"
   },
-  "weekday.short.1": {
-    "description": "Short name of Monday",
-    "other": "一"
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "jum. I"
   },
-  "weekday.short.2": {
-    "description": "Short name of Tuesday",
-    "other": "二"
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "jum. II"
   },
-  "weekday.short.3": {
-    "description": "Short name of Wednesday",
-    "other": "三"
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "raj."
   },
-  "weekday.short.4": {
-    "description": "Short name of Thursday",
-    "other": "四"
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "sha."
   },
-  "weekday.short.5": {
-    "description": "Short name of Friday",
-    "other": "五"
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "ram."
   },
-  "weekday.short.6": {
-    "description": "Short name of Saturday",
-    "other": "六"
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "shaw."
+  },
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "dhuʻl-q."
+  },
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "dhuʻl-h."
+  },
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "farvardin"
+  },
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "ordibehesht"
+  },
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "khordad"
+  },
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "tir"
+  },
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "mordad"
+  },
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "shahrivar"
+  },
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "mehr"
+  },
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "aban"
+  },
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "azar"
+  },
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "dey"
+  },
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "bahman"
+  },
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "esfand"
+  },
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "farvardin"
+  },
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "ordibehesht"
+  },
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "khordad"
+  },
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "tir"
+  },
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "mordad"
+  },
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "shahrivar"
+  },
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "mehr"
+  },
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "aban"
+  },
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "azar"
+  },
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "dey"
+  },
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "bahman"
+  },
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "esfand"
+  },
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "tishri"
+  },
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "heshvan"
+  },
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "kislev"
+  },
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "tevet"
+  },
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "shevat"
+  },
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "adar I"
+  },
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "adar"
+  },
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "adar II"
+  },
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "nisan"
+  },
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "iyar"
+  },
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "sivan"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "tamuz"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "av"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "elul"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "tishri"
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "heshvan"
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "kislev"
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "tevet"
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "shevat"
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "adar I"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "adar"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "adar II"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "nisan"
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "iyar"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "sivan"
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "tamuz"
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "av"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "elul"
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Primer mes"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Segundo mes"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Tercer mes"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Cuarto mes"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Quinto mes"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Sexto mes"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Séptimo mes"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Octavo mes"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Noveno mes"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Décimo mes"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Undécimo mes"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Duodécimo mes"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M01"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M02"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M03"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M04"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M05"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M06"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M07"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M08"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M09"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M10"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M11"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M12"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "{{.Month}} bis"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "13"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "14"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "15"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "16"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "17"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "18"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "19"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "20"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "21"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "22"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "23"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "24"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "25"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "26"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "27"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "28"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "29"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "30"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Day}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Month}} {{.Day}}"
   }
-}`
-
-var vietnameseTranslation = `{
+}`,
+	"fa": `{
   "month.long.1": {
     "description": "Full name of January",
-    "other": "Tháng Một"
+    "other": "ژانویه"
   },
   "month.long.2": {
     "description": "Full name of February",
-    "other": "Tháng Hai"
+    "other": "فوریه"
   },
   "month.long.3": {
     "description": "Full name of March",
-    "other": "Tháng Ba"
+    "other": "مارس"
   },
   "month.long.4": {
     "description": "Full name of April",
-    "other": "Tháng Tư"
+    "other": "آوریل"
   },
   "month.long.5": {
     "description": "Full name of May",
-    "other": "Tháng Năm"
+    "other": "مه"
   },
   "month.long.6": {
     "description": "Full name of June",
-    "other": "Tháng Sáu"
+    "other": "ژوئن"
   },
   "month.long.7": {
     "description": "Full name of July",
-    "other": "Tháng Bảy"
+    "other": "ژوئیه"
   },
   "month.long.8": {
     "description": "Full name of August",
-    "other": "Tháng Tám"
+    "other": "اوت"
   },
   "month.long.9": {
     "description": "Full name of September",
-    "other": "Tháng Chín"
+    "other": "سپتامبر"
   },
   "month.long.10": {
     "description": "Full name of October",
-    "other": "Tháng Mười"
+    "other": "اکتبر"
   },
   "month.long.11": {
     "description": "Full name of November",
-    "other": "Tháng Mười Một"
+    "other": "نوامبر"
   },
   "month.long.12": {
     "description": "Full name of December",
-    "other": "Tháng Mười Hai"
+    "other": "دسامبر"
   },
   "month.short.1": {
     "description": "Short name of January",
-    "other": "Th1"
+    "other": "ژانویه"
   },
   "month.short.2": {
     "description": "Short name of February",
-    "other": "Th2"
+    "other": "فوریه"
   },
   "month.short.3": {
     "description": "Short name of March",
-    "other": "Th3"
+    "other": "مارس"
   },
   "month.short.4": {
     "description": "Short name of April",
-    "other": "Th4"
+    "other": "آوریل"
   },
   "month.short.5": {
     "description": "Short name of May",
-    "other": "Th5"
+    "other": "مه"
   },
   "month.short.6": {
     "description": "Short name of June",
-    "other": "Th6"
+    "other": "ژوئن"
   },
   "month.short.7": {
     "description": "Short name of July",
-    "other": "Th7"
+    "other": "ژوئیه"
   },
   "month.short.8": {
     "description": "Short name of August",
-    "other": "Th8"
+    "other": "اوت"
   },
   "month.short.9": {
     "description": "Short name of September",
-    "other": "Th9"
+    "other": "سپتامبر"
   },
   "month.short.10": {
     "description": "Short name of October",
-    "other": "Th10"
+    "other": "اکتبر"
   },
   "month.short.11": {
     "description": "Short name of November",
-    "other": "Th11"
+    "other": "نوامبر"
   },
   "month.short.12": {
     "description": "Short name of December",
-    "other": "Th12"
+    "other": "دسامبر"
   },
   "weekday.long.0": {
     "description": "Full name of Sunday",
-    "other": "Chủ Nhật"
+    "other": "یکشنبه"
   },
   "weekday.long.1": {
     "description": "Full name of Monday",
-    "other": "Thứ Hai"
+    "other": "دوشنبه"
   },
   "weekday.long.2": {
     "description": "Full name of Tuesday",
-    "other": "Thứ Ba"
+    "other": "سه‌شنبه"
   },
   "weekday.long.3": {
     "description": "Full name of Wednesday",
-    "other": "Thứ Tư"
+    "other": "چهارشنبه"
   },
   "weekday.long.4": {
     "description": "Full name of Thursday",
-    "other": "Thứ Năm"
+    "other": "پنجشنبه"
   },
   "weekday.long.5": {
     "description": "Full name of Friday",
-    "other": "Thứ Sáu"
+    "other": "جمعه"
   },
   "weekday.long.6": {
     "description": "Full name of Saturday",
-    "other": "Thứ Bảy"
+    "other": "شنبه"
   },
   "weekday.short.0": {
     "description": "Short name of Sunday",
-    "other": "CN"
+    "other": "یکشنبه"
   },
   "weekday.short.1": {
     "description": "Short name of Monday",
-    "other": "T2"
+    "other": "دوشنبه"
   },
   "weekday.short.2": {
     "description": "Short name of Tuesday",
-    "other": "T3"
+    "other": "سه‌شنبه"
   },
   "weekday.short.3": {
     "description": "Short name of Wednesday",
-    "other": "T4"
+    "other": "چهارشنبه"
   },
   "weekday.short.4": {
     "description": "Short name of Thursday",
-    "other": "T5"
+    "other": "پنجشنبه"
   },
   "weekday.short.5": {
     "description": "Short name of Friday",
-    "other": "T6"
+    "other": "جمعه"
   },
   "weekday.short.6": {
     "description": "Short name of Saturday",
-    "other": "T7"
-  }
-}`
+    "other": "شنبه"
+  },
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "اکنون"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "one": "{{.Count}} دقیقه پیش",
+    "other": "{{.Count}} دقیقه پیش"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "one": "{{.Count}} ساعت پیش",
+    "other": "{{.Count}} ساعت پیش"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "one": "{{.Count}} روز پیش",
+    "other": "{{.Count}} روز پیش"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "one": "{{.Count}} هفته پیش",
+    "other": "{{.Count}} هفته پیش"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "one": "{{.Count}} ماه پیش",
+    "other": "{{.Count}} ماه پیش"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "one": "{{.Count}} سال پیش",
+    "other": "{{.Count}} سال پیش"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "one": "{{.Count}} دقیقه بعد",
+    "other": "{{.Count}} دقیقه بعد"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "one": "{{.Count}} ساعت بعد",
+    "other": "{{.Count}} ساعت بعد"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "one": "{{.Count}} روز بعد",
+    "other": "{{.Count}} روز بعد"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "one": "{{.Count}} هفته بعد",
+    "other": "{{.Count}} هفته بعد"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "one": "{{.Count}} ماه بعد",
+    "other": "{{.Count}} ماه بعد"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "one": "{{.Count}} سال بعد",
+    "other": "{{.Count}} سال بعد"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "one": "{{.Count}} روز",
+    "other": "{{.Count}} روز"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "one": "{{.Count}} ساعت",
+    "other": "{{.Count}} ساعت"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "one": "{{.Count}} دقیقه",
+    "other": "{{.Count}} دقیقه"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "one": "{{.Count}} ثانیه",
+    "other": "{{.Count}} ثانیه"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}} و {{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}، {{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}} و {{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": "، "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "از {{.From}} تا {{.To}}"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "سه‌ماهه {{.Quarter}} سال {{.Year}}"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{.Day}} {{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Weekday}} {{.Date}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "{{.Time}} ق.ظ."
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "{{.Time}} ب.ظ."
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "روزانه"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "هر {{.Count}} روز"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "هفتگی"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "هر {{.Count}} هفته"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "ماهانه"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "هر {{.Count}} ماه"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "سالانه"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "هر {{.Count}} سال"
+  },
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "هر {{.Days}}"
+  },
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "هر {{.Count}} هفته در {{.Days}}"
+  },
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}} در {{.Days}}"
+  },
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "روز {{.Day}}"
+  },
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "آخرین روز"
+  },
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "{{.Weekday}} {{.Nth}}"
+  },
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "اول"
+  },
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "دوم"
+  },
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "سوم"
+  },
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "چهارم"
+  },
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "پنجم"
+  },
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "آخر"
+  },
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "یک بار"
+  },
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "other": "{{.Count}} بار"
+  },
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "محرم"
+  },
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "صفر"
+  },
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "ربیع‌الاول"
+  },
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "ربیع‌الثانی"
+  },
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "جمادی‌الاول"
+  },
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "جمادی‌الثانی"
+  },
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "رجب"
+  },
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "شعبان"
+  },
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "رمضان"
+  },
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "شوال"
+  },
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "ذیقعده"
+  },
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "ذیحجه"
+  },
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "محرم"
+  },
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "صفر"
+  },
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "ربیع‌الاول"
+  },
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "ربیع‌الثانی"
+  },
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "جمادی‌الاول"
+  },
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "جمادی‌الثانی"
+  },
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "رجب"
+  },
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "شعبان"
+  },
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "رمضان"
+  },
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "شوال"
+  },
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "ذیقعده"
+  },
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "ذیحجه"
+  },
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "فروردین"
+  },
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "اردیبهشت"
+  },
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "خرداد"
+  },
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "تیر"
+  },
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "مرداد"
+  },
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "شهریور"
+  },
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "مهر"
+  },
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "آبان"
+  },
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "آذر"
+  },
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "دی"
+  },
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "بهمن"
+  },
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "اسفند"
+  },
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "فروردین"
+  },
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "اردیبهشت"
+  },
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "خرداد"
+  },
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "تیر"
+  },
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "مرداد"
+  },
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "شهریور"
+  },
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "مهر"
+  },
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "آبان"
+  },
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "آذر"
+  },
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "دی"
+  },
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "بهمن"
+  },
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "اسفند"
+  },
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "تشری"
+  },
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "حشوان"
+  },
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "کسلو"
+  },
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "طوت"
+  },
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "شباط"
+  },
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "آذار"
+  },
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "واذار"
+  },
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "واذار الثانی"
+  },
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "نیسان"
+  },
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "ایار"
+  },
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "سیوان"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "تموز"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "آب"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "ایلول"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "تشری"
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "حشوان"
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "کسلو"
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "طوت"
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "شباط"
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "آذار"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "واذار"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "واذار الثانی"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "نیسان"
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "ایار"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "سیوان"
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "تموز"
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "آب"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "ایلول"
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M01"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M02"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M03"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M04"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M05"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M06"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M07"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M08"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M09"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M10"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M11"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M12"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M01"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M02"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M03"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M04"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M05"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M06"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M07"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M08"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M09"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M10"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M11"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M12"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "{{.Month}}bis"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "13"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "14"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "15"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "16"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "17"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "18"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "19"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "20"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "21"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "22"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "23"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "24"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "25"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "26"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "27"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "28"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "29"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "30"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Day}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Month}} {{.Day}}"
+  }
+}`,
+	"fr": `{
+  "month.long.1": {
+    "description": "Full name of January",
+    "other": "janvier"
+  },
+  "month.long.2": {
+    "description": "Full name of February",
+    "other": "février"
+  },
+  "month.long.3": {
+    "description": "Full name of March",
+    "other": "mars"
+  },
+  "month.long.4": {
+    "description": "Full name of April",
+    "other": "avril"
+  },
+  "month.long.5": {
+    "description": "Full name of May",
+    "other": "mai"
+  },
+  "month.long.6": {
+    "description": "Full name of June",
+    "other": "juin"
+  },
+  "month.long.7": {
+    "description": "Full name of July",
+    "other": "juillet"
+  },
+  "month.long.8": {
+    "description": "Full name of August",
+    "other": "août"
+  },
+  "month.long.9": {
+    "description": "Full name of September",
+    "other": "septembre"
+  },
+  "month.long.10": {
+    "description": "Full name of October",
+    "other": "octobre"
+  },
+  "month.long.11": {
+    "description": "Full name of November",
+    "other": "novembre"
+  },
+  "month.long.12": {
+    "description": "Full name of December",
+    "other": "décembre"
+  },
+  "month.short.1": {
+    "description": "Short name of January",
+    "other": "janv."
+  },
+  "month.short.2": {
+    "description": "Short name of February",
+    "other": "févr."
+  },
+  "month.short.3": {
+    "description": "Short name of March",
+    "other": "mars"
+  },
+  "month.short.4": {
+    "description": "Short name of April",
+    "other": "avr."
+  },
+  "month.short.5": {
+    "description": "Short name of May",
+    "other": "mai"
+  },
+  "month.short.6": {
+    "description": "Short name of June",
+    "other": "juin"
+  },
+  "month.short.7": {
+    "description": "Short name of July",
+    "other": "juil."
+  },
+  "month.short.8": {
+    "description": "Short name of August",
+    "other": "août"
+  },
+  "month.short.9": {
+    "description": "Short name of September",
+    "other": "sept."
+  },
+  "month.short.10": {
+    "description": "Short name of October",
+    "other": "oct."
+  },
+  "month.short.11": {
+    "description": "Short name of November",
+    "other": "nov."
+  },
+  "month.short.12": {
+    "description": "Short name of December",
+    "other": "déc."
+  },
+  "weekday.long.0": {
+    "description": "Full name of Sunday",
+    "other": "dimanche"
+  },
+  "weekday.long.1": {
+    "description": "Full name of Monday",
+    "other": "lundi"
+  },
+  "weekday.long.2": {
+    "description": "Full name of Tuesday",
+    "other": "mardi"
+  },
+  "weekday.long.3": {
+    "description": "Full name of Wednesday",
+    "other": "mercredi"
+  },
+  "weekday.long.4": {
+    "description": "Full name of Thursday",
+    "other": "jeudi"
+  },
+  "weekday.long.5": {
+    "description": "Full name of Friday",
+    "other": "vendredi"
+  },
+  "weekday.long.6": {
+    "description": "Full name of Saturday",
+    "other": "samedi"
+  },
+  "weekday.short.0": {
+    "description": "Short name of Sunday",
+    "other": "dim."
+  },
+  "weekday.short.1": {
+    "description": "Short name of Monday",
+    "other": "lun."
+  },
+  "weekday.short.2": {
+    "description": "Short name of Tuesday",
+    "other": "mar."
+  },
+  "weekday.short.3": {
+    "description": "Short name of Wednesday",
+    "other": "mer."
+  },
+  "weekday.short.4": {
+    "description": "Short name of Thursday",
+    "other": "jeu."
+  },
+  "weekday.short.5": {
+    "description": "Short name of Friday",
+    "other": "ven."
+  },
+  "weekday.short.6": {
+    "description": "Short name of Saturday",
+    "other": "sam."
+  },
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "maintenant"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "one": "il y a {{.Count}} minute",
+    "other": "il y a {{.Count}} minutes"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "one": "il y a {{.Count}} heure",
+    "other": "il y a {{.Count}} heures"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "one": "il y a {{.Count}} jour",
+    "other": "il y a {{.Count}} jours"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "one": "il y a {{.Count}} semaine",
+    "other": "il y a {{.Count}} semaines"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "one": "il y a {{.Count}} mois",
+    "other": "il y a {{.Count}} mois"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "one": "il y a {{.Count}} an",
+    "other": "il y a {{.Count}} ans"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "one": "dans {{.Count}} minute",
+    "other": "dans {{.Count}} minutes"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "one": "dans {{.Count}} heure",
+    "other": "dans {{.Count}} heures"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "one": "dans {{.Count}} jour",
+    "other": "dans {{.Count}} jours"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "one": "dans {{.Count}} semaine",
+    "other": "dans {{.Count}} semaines"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "one": "dans {{.Count}} mois",
+    "other": "dans {{.Count}} mois"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "one": "dans {{.Count}} an",
+    "other": "dans {{.Count}} ans"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "one": "{{.Count}} jour",
+    "other": "{{.Count}} jours"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "one": "{{.Count}} heure",
+    "other": "{{.Count}} heures"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "one": "{{.Count}} minute",
+    "other": "{{.Count}} minutes"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "one": "{{.Count}} seconde",
+    "other": "{{.Count}} secondes"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}} {{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}, {{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}} et {{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": ", "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "du {{.From}} au {{.To}}"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "trimestre {{.Quarter}} {{.Year}}"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{.Day}} {{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Weekday}} {{.Date}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "{{.Time}} AM"
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "{{.Time}} PM"
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "Tous les jours"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "Tous les {{.Count}} jours"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "Toutes les semaines"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "Toutes les {{.Count}} semaines"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "Tous les mois"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "Tous les {{.Count}} mois"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "Tous les ans"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "Tous les {{.Count}} ans"
+  },
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "Chaque {{.Days}}"
+  },
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "Toutes les {{.Count}} semaines le {{.Days}}"
+  },
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}} le {{.Days}}"
+  },
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "{{.Day}}"
+  },
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "dernier jour"
+  },
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "{{.Nth}} {{.Weekday}}"
+  },
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "1er"
+  },
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "2e"
+  },
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "3e"
+  },
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "4e"
+  },
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "5e"
+  },
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "dernier"
+  },
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "une fois"
+  },
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "other": "{{.Count}} fois"
+  },
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "mouharram"
+  },
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "safar"
+  },
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "rabia al awal"
+  },
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "rabia ath-thani"
+  },
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "joumada al oula"
+  },
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "joumada ath-thania"
+  },
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "rajab"
+  },
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "chaabane"
+  },
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "ramadan"
+  },
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "chawwal"
+  },
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "dhou al qiʿda"
+  },
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "dhou al-hijja"
+  },
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "mouh."
+  },
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "saf."
+  },
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "rab. aw."
+  },
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "rab. th."
+  },
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "joum. ou."
+  },
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "joum. th."
+  },
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "raj."
+  },
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "chaa."
+  },
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "ram."
+  },
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "chaw."
+  },
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "dhou. qi."
+  },
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "dhou. hi."
+  },
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "farvardin"
+  },
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "ordibehešt"
+  },
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "khordâd"
+  },
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "tir"
+  },
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "mordâd"
+  },
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "šahrivar"
+  },
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "mehr"
+  },
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "âbân"
+  },
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "âzar"
+  },
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "dey"
+  },
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "bahman"
+  },
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "esfand"
+  },
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "far."
+  },
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "ord."
+  },
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "kho."
+  },
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "tir"
+  },
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "mor."
+  },
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "šah."
+  },
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "mehr"
+  },
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "âbân"
+  },
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "âzar"
+  },
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "dey"
+  },
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "bah."
+  },
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "esf."
+  },
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "tichri"
+  },
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "hèchvan"
+  },
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "kislev"
+  },
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "téveth"
+  },
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "chevat"
+  },
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "adar I"
+  },
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "adar"
+  },
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "adar II"
+  },
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "nissan"
+  },
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "iyar"
+  },
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "sivan"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "tamouz"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "av"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "éloul"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "tich."
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "hèch."
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "kis."
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "tév."
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "chev."
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "ad.I"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "adar"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "ad.II"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "nis."
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "iyar"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "siv."
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "tam."
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "av"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "él."
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "zhēngyuè"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "èryuè"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "sānyuè"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "sìyuè"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "wǔyuè"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "liùyuè"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "qīyuè"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "bāyuè"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "jiǔyuè"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "shíyuè"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "shíyīyuè"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "shí’èryuè"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1yuè"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2yuè"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3yuè"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4yuè"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5yuè"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6yuè"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7yuè"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8yuè"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9yuè"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10yuè"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11yuè"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12yuè"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "{{.Month}} bis"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "13"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "14"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "15"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "16"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "17"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "18"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "19"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "20"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "21"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "22"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "23"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "24"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "25"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "26"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "27"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "28"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "29"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "30"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Day}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Month}} {{.Day}}"
+  }
+}`,
+	"he": `{
+  "month.long.1": {
+    "description": "Full name of January",
+    "other": "ינואר"
+  },
+  "month.long.2": {
+    "description": "Full name of February",
+    "other": "פברואר"
+  },
+  "month.long.3": {
+    "description": "Full name of March",
+    "other": "מרץ"
+  },
+  "month.long.4": {
+    "description": "Full name of April",
+    "other": "אפריל"
+  },
+  "month.long.5": {
+    "description": "Full name of May",
+    "other": "מאי"
+  },
+  "month.long.6": {
+    "description": "Full name of June",
+    "other": "יוני"
+  },
+  "month.long.7": {
+    "description": "Full name of July",
+    "other": "יולי"
+  },
+  "month.long.8": {
+    "description": "Full name of August",
+    "other": "אוגוסט"
+  },
+  "month.long.9": {
+    "description": "Full name of September",
+    "other": "ספטמבר"
+  },
+  "month.long.10": {
+    "description": "Full name of October",
+    "other": "אוקטובר"
+  },
+  "month.long.11": {
+    "description": "Full name of November",
+    "other": "נובמבר"
+  },
+  "month.long.12": {
+    "description": "Full name of December",
+    "other": "דצמבר"
+  },
+  "month.short.1": {
+    "description": "Short name of January",
+    "other": "ינו׳"
+  },
+  "month.short.2": {
+    "description": "Short name of February",
+    "other": "פבר׳"
+  },
+  "month.short.3": {
+    "description": "Short name of March",
+    "other": "מרץ"
+  },
+  "month.short.4": {
+    "description": "Short name of April",
+    "other": "אפר׳"
+  },
+  "month.short.5": {
+    "description": "Short name of May",
+    "other": "מאי"
+  },
+  "month.short.6": {
+    "description": "Short name of June",
+    "other": "יוני"
+  },
+  "month.short.7": {
+    "description": "Short name of July",
+    "other": "יולי"
+  },
+  "month.short.8": {
+    "description": "Short name of August",
+    "other": "אוג׳"
+  },
+  "month.short.9": {
+    "description": "Short name of September",
+    "other": "ספט׳"
+  },
+  "month.short.10": {
+    "description": "Short name of October",
+    "other": "אוק׳"
+  },
+  "month.short.11": {
+    "description": "Short name of November",
+    "other": "נוב׳"
+  },
+  "month.short.12": {
+    "description": "Short name of December",
+    "other": "דצמ׳"
+  },
+  "weekday.long.0": {
+    "description": "Full name of Sunday",
+    "other": "יום ראשון"
+  },
+  "weekday.long.1": {
+    "description": "Full name of Monday",
+    "other": "יום שני"
+  },
+  "weekday.long.2": {
+    "description": "Full name of Tuesday",
+    "other": "יום שלישי"
+  },
+  "weekday.long.3": {
+    "description": "Full name of Wednesday",
+    "other": "יום רביעי"
+  },
+  "weekday.long.4": {
+    "description": "Full name of Thursday",
+    "other": "יום חמישי"
+  },
+  "weekday.long.5": {
+    "description": "Full name of Friday",
+    "other": "יום שישי"
+  },
+  "weekday.long.6": {
+    "description": "Full name of Saturday",
+    "other": "יום שבת"
+  },
+  "weekday.short.0": {
+    "description": "Short name of Sunday",
+    "other": "יום א׳"
+  },
+  "weekday.short.1": {
+    "description": "Short name of Monday",
+    "other": "יום ב׳"
+  },
+  "weekday.short.2": {
+    "description": "Short name of Tuesday",
+    "other": "יום ג׳"
+  },
+  "weekday.short.3": {
+    "description": "Short name of Wednesday",
+    "other": "יום ד׳"
+  },
+  "weekday.short.4": {
+    "description": "Short name of Thursday",
+    "other": "יום ה׳"
+  },
+  "weekday.short.5": {
+    "description": "Short name of Friday",
+    "other": "יום ו׳"
+  },
+  "weekday.short.6": {
+    "description": "Short name of Saturday",
+    "other": "שבת"
+  },
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "עכשיו"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "one": "לפני דקה",
+    "two": "לפני {{.Count}} דקות",
+    "many": "לפני {{.Count}} דקות",
+    "other": "לפני {{.Count}} דקות"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "one": "לפני שעה",
+    "two": "לפני שעתיים",
+    "many": "לפני {{.Count}} שעות",
+    "other": "לפני {{.Count}} שעות"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "one": "לפני יום",
+    "two": "לפני יומיים",
+    "many": "לפני {{.Count}} ימים",
+    "other": "לפני {{.Count}} ימים"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "one": "לפני שבוע",
+    "two": "לפני שבועיים",
+    "many": "לפני {{.Count}} שבועות",
+    "other": "לפני {{.Count}} שבועות"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "one": "לפני חודש",
+    "two": "לפני חודשיים",
+    "many": "לפני {{.Count}} חודשים",
+    "other": "לפני {{.Count}} חודשים"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "one": "לפני שנה",
+    "two": "לפני שנתיים",
+    "many": "לפני {{.Count}} שנים",
+    "other": "לפני {{.Count}} שנים"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "one": "בעוד דקה",
+    "two": "בעוד {{.Count}} דקות",
+    "many": "בעוד {{.Count}} דקות",
+    "other": "בעוד {{.Count}} דקות"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "one": "בעוד שעה",
+    "two": "בעוד שעתיים",
+    "many": "בעוד {{.Count}} שעות",
+    "other": "בעוד {{.Count}} שעות"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "one": "בעוד יום",
+    "two": "בעוד יומיים",
+    "many": "בעוד {{.Count}} ימים",
+    "other": "בעוד {{.Count}} ימים"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "one": "בעוד שבוע",
+    "two": "בעוד שבועיים",
+    "many": "בעוד {{.Count}} שבועות",
+    "other": "בעוד {{.Count}} שבועות"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "one": "בעוד חודש",
+    "two": "בעוד חודשיים",
+    "many": "בעוד {{.Count}} חודשים",
+    "other": "בעוד {{.Count}} חודשים"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "one": "בעוד שנה",
+    "two": "בעוד שנתיים",
+    "many": "בעוד {{.Count}} שנים",
+    "other": "בעוד {{.Count}} שנים"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "one": "יום",
+    "two": "יומיים",
+    "many": "{{.Count}} ימים",
+    "other": "{{.Count}} ימים"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "one": "שעה",
+    "two": "שעתיים",
+    "many": "{{.Count}} שעות",
+    "other": "{{.Count}} שעות"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "one": "דקה",
+    "two": "{{.Count}} דקות",
+    "many": "{{.Count}} דקות",
+    "other": "{{.Count}} דקות"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "one": "שנייה",
+    "two": "{{.Count}} שניות",
+    "many": "{{.Count}} שניות",
+    "other": "{{.Count}} שניות"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}} ו{{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}, {{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}} ו{{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": ", "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "{{.From}} עד {{.To}}"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "רבעון {{.Quarter}} {{.Year}}"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{.Day}} ב{{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Weekday}}, {{.Date}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "{{.Time}} לפנה״צ"
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "{{.Time}} אחה״צ"
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "כל יום"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "כל {{.Count}} ימים"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "כל שבוע"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "כל {{.Count}} שבועות"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "כל חודש"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "כל {{.Count}} חודשים"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "כל שנה"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "כל {{.Count}} שנים"
+  },
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "כל {{.Days}}"
+  },
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "כל {{.Count}} שבועות ב{{.Days}}"
+  },
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}} ב{{.Days}}"
+  },
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "יום {{.Day}}"
+  },
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "היום האחרון"
+  },
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "{{.Weekday}} ה{{.Nth}}"
+  },
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "ראשון"
+  },
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "שני"
+  },
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "שלישי"
+  },
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "רביעי"
+  },
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "חמישי"
+  },
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "אחרון"
+  },
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "פעם אחת"
+  },
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "one": "פעם אחת",
+    "two": "פעמיים",
+    "many": "{{.Count}} פעמים",
+    "other": "{{.Count}} פעמים"
+  },
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "מוחרם"
+  },
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "צפר"
+  },
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "רביע אל־אוול"
+  },
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "רביע א־ת׳אני"
+  },
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "ג׳ומאדא אל־אולא"
+  },
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "ג׳ומאדא א־ת׳אניה"
+  },
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "רג׳ב"
+  },
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "שעבאן"
+  },
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "רמדאן"
+  },
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "שוואל"
+  },
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "ד׳ו אל־קעדה"
+  },
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "ד׳ו אל־חיג׳ה"
+  },
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "מוחרם"
+  },
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "צפר"
+  },
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "רביע א׳"
+  },
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "רביע ב׳"
+  },
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "ג׳ומאדא א׳"
+  },
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "ג׳ומאדא ב׳"
+  },
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "רג׳ב"
+  },
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "שעבאן"
+  },
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "רמדאן"
+  },
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "שוואל"
+  },
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "ד׳ו אל־קעדה"
+  },
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "ד׳ו אל־חיג׳ה"
+  },
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "פרורדין"
+  },
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "ארדיבהשת"
+  },
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "ח׳רדאד"
+  },
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "תיר"
+  },
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "מרדאד"
+  },
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "שהריור"
+  },
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "מהר"
+  },
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "אבאן"
+  },
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "אד׳ר"
+  },
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "די"
+  },
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "בהמן"
+  },
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "אספנד"
+  },
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "פרורדין"
+  },
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "ארדיבהשת"
+  },
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "ח׳רדאד"
+  },
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "תיר"
+  },
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "מרדאד"
+  },
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "שהריור"
+  },
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "מהר"
+  },
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "אבאן"
+  },
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "אד׳ר"
+  },
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "די"
+  },
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "בהמן"
+  },
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "אספנד"
+  },
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "תשרי"
+  },
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "חשוון"
+  },
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "כסלו"
+  },
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "טבת"
+  },
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "שבט"
+  },
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "אדר א׳"
+  },
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "אדר"
+  },
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "אדר ב׳"
+  },
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "ניסן"
+  },
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "אייר"
+  },
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "סיוון"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "תמוז"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "אב"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "אלול"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "תשרי"
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "חשון"
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "כסלו"
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "טבת"
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "שבט"
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "אדר א׳"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "אדר"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "אדר ב׳"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "ניסן"
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "אייר"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "סיון"
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "תמוז"
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "אב"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "אלול"
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M01"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M02"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M03"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M04"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M05"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M06"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M07"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M08"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M09"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M10"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M11"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M12"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M01"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M02"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M03"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M04"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M05"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M06"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M07"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M08"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M09"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M10"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M11"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M12"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "{{.Month}}bis"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "13"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "14"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "15"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "16"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "17"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "18"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "19"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "20"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "21"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "22"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "23"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "24"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "25"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "26"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "27"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "28"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "29"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "30"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Day}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Month}} {{.Day}}"
+  }
+}`,
+	"ja": `{
+  "month.long.1": {
+    "description": "Full name of January",
+    "other": "1月"
+  },
+  "month.long.2": {
+    "description": "Full name of February",
+    "other": "2月"
+  },
+  "month.long.3": {
+    "description": "Full name of March",
+    "other": "3月"
+  },
+  "month.long.4": {
+    "description": "Full name of April",
+    "other": "4月"
+  },
+  "month.long.5": {
+    "description": "Full name of May",
+    "other": "5月"
+  },
+  "month.long.6": {
+    "description": "Full name of June",
+    "other": "6月"
+  },
+  "month.long.7": {
+    "description": "Full name of July",
+    "other": "7月"
+  },
+  "month.long.8": {
+    "description": "Full name of August",
+    "other": "8月"
+  },
+  "month.long.9": {
+    "description": "Full name of September",
+    "other": "9月"
+  },
+  "month.long.10": {
+    "description": "Full name of October",
+    "other": "10月"
+  },
+  "month.long.11": {
+    "description": "Full name of November",
+    "other": "11月"
+  },
+  "month.long.12": {
+    "description": "Full name of December",
+    "other": "12月"
+  },
+  "month.short.1": {
+    "description": "Short name of January",
+    "other": "1月"
+  },
+  "month.short.2": {
+    "description": "Short name of February",
+    "other": "2月"
+  },
+  "month.short.3": {
+    "description": "Short name of March",
+    "other": "3月"
+  },
+  "month.short.4": {
+    "description": "Short name of April",
+    "other": "4月"
+  },
+  "month.short.5": {
+    "description": "Short name of May",
+    "other": "5月"
+  },
+  "month.short.6": {
+    "description": "Short name of June",
+    "other": "6月"
+  },
+  "month.short.7": {
+    "description": "Short name of July",
+    "other": "7月"
+  },
+  "month.short.8": {
+    "description": "Short name of August",
+    "other": "8月"
+  },
+  "month.short.9": {
+    "description": "Short name of September",
+    "other": "9月"
+  },
+  "month.short.10": {
+    "description": "Short name of October",
+    "other": "10月"
+  },
+  "month.short.11": {
+    "description": "Short name of November",
+    "other": "11月"
+  },
+  "month.short.12": {
+    "description": "Short name of December",
+    "other": "12月"
+  },
+  "weekday.long.0": {
+    "description": "Full name of Sunday",
+    "other": "日曜日"
+  },
+  "weekday.long.1": {
+    "description": "Full name of Monday",
+    "other": "月曜日"
+  },
+  "weekday.long.2": {
+    "description": "Full name of Tuesday",
+    "other": "火曜日"
+  },
+  "weekday.long.3": {
+    "description": "Full name of Wednesday",
+    "other": "水曜日"
+  },
+  "weekday.long.4": {
+    "description": "Full name of Thursday",
+    "other": "木曜日"
+  },
+  "weekday.long.5": {
+    "description": "Full name of Friday",
+    "other": "金曜日"
+  },
+  "weekday.long.6": {
+    "description": "Full name of Saturday",
+    "other": "土曜日"
+  },
+  "weekday.short.0": {
+    "description": "Short name of Sunday",
+    "other": "日"
+  },
+  "weekday.short.1": {
+    "description": "Short name of Monday",
+    "other": "月"
+  },
+  "weekday.short.2": {
+    "description": "Short name of Tuesday",
+    "other": "火"
+  },
+  "weekday.short.3": {
+    "description": "Short name of Wednesday",
+    "other": "水"
+  },
+  "weekday.short.4": {
+    "description": "Short name of Thursday",
+    "other": "木"
+  },
+  "weekday.short.5": {
+    "description": "Short name of Friday",
+    "other": "金"
+  },
+  "weekday.short.6": {
+    "description": "Short name of Saturday",
+    "other": "土"
+  },
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "今"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "other": "{{.Count}}分前"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "other": "{{.Count}}時間前"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "other": "{{.Count}}日前"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "other": "{{.Count}}週間前"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "other": "{{.Count}}か月前"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "other": "{{.Count}}年前"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "other": "{{.Count}}分後"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "other": "{{.Count}}時間後"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "other": "{{.Count}}日後"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "other": "{{.Count}}週間後"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "other": "{{.Count}}か月後"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "other": "{{.Count}}年後"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "other": "{{.Count}}日"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "other": "{{.Count}}時間"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "other": "{{.Count}}分"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "other": "{{.Count}}秒"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}}{{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}、{{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}}、{{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": ", "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "{{.From}}から{{.To}}まで"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "{{.Year}}年第{{.Quarter}}四半期"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{if .Year}}{{.Year}}年{{end}}{{.Month}}{{.Day}}日"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}年"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{if .Year}}{{.Year}}年{{end}}{{.Month}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Date}}{{.Weekday}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "午前{{.Hour}}時{{if .Minute}}{{.Minute}}分{{end}}"
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "午後{{.Hour}}時{{if .Minute}}{{.Minute}}分{{end}}"
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "毎日"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "{{.Count}}日ごと"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "毎週"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "{{.Count}}週間ごと"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "毎月"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "{{.Count}}か月ごと"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "毎年"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "{{.Count}}年ごと"
+  },
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "毎週{{.Days}}"
+  },
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "{{.Count}}週間ごとの{{.Days}}"
+  },
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}}{{.Days}}"
+  },
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "{{.Day}}日"
+  },
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "末日"
+  },
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "{{.Nth}}{{.Weekday}}"
+  },
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "第1"
+  },
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "第2"
+  },
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "第3"
+  },
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "第4"
+  },
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "第5"
+  },
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "最終"
+  },
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "1回"
+  },
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "other": "{{.Count}}回"
+  },
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "ムハッラム"
+  },
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "サフアル"
+  },
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "ラビー・ウル・アウワル"
+  },
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "ラビー・ウッ・サーニー"
+  },
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "ジュマーダル・アウワル"
+  },
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "ジュマーダッサーニー"
+  },
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "ラジャブ"
+  },
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "シャアバーン"
+  },
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "ラマダーン"
+  },
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "シャウワール"
+  },
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "ズル・カイダ"
+  },
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "ズル・ヒッジャ"
+  },
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "ムハッラム"
+  },
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "サフアル"
+  },
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "ラビー・ウル・アウワル"
+  },
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "ラビー・ウッ・サーニー"
+  },
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "ジュマーダル・アウワル"
+  },
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "ジュマーダッサーニー"
+  },
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "ラジャブ"
+  },
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "シャアバーン"
+  },
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "ラマダーン"
+  },
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "シャウワール"
+  },
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "ズル・カイダ"
+  },
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "ズル・ヒッジャ"
+  },
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "ファルヴァルディーン"
+  },
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "オルディーベヘシュト"
+  },
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "ホルダード"
+  },
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "ティール"
+  },
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "モルダード"
+  },
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "シャハリーヴァル"
+  },
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "メフル"
+  },
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "アーバーン"
+  },
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "アーザル"
+  },
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "デイ"
+  },
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "バフマン"
+  },
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "エスファンド"
+  },
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "ファルヴァルディーン"
+  },
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "オルディーベヘシュト"
+  },
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "ホルダード"
+  },
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "ティール"
+  },
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "モルダード"
+  },
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "シャハリーヴァル"
+  },
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "メフル"
+  },
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "アーバーン"
+  },
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "アーザル"
+  },
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "デイ"
+  },
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "バフマン"
+  },
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "エスファンド"
+  },
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "ティスレ"
+  },
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "へシボン"
+  },
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "キスレブ"
+  },
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "テベット"
+  },
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "シバット"
+  },
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "アダル I"
+  },
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "アダル"
+  },
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "アダル II"
+  },
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "ニサン"
+  },
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "イヤル"
+  },
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "シバン"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "タムズ"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "アヴ"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "エルル"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "ティスレ"
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "へシボン"
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "キスレブ"
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "テベット"
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "シバット"
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "アダル I"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "アダル"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "アダル II"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "ニサン"
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "イヤル"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "シバン"
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "タムズ"
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "アヴ"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "エルル"
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "正月"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "二月"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "三月"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "四月"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "五月"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "六月"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "七月"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "八月"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "九月"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十月"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十一月"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十二月"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "正月"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "二月"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "三月"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "四月"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "五月"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "六月"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "七月"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "八月"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "九月"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十月"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十一月"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十二月"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "閏{{.Month}}"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1日"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2日"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3日"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4日"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5日"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6日"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7日"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8日"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9日"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10日"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11日"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12日"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "13日"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "14日"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "15日"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "16日"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "17日"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "18日"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "19日"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "20日"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "21日"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "22日"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "23日"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "24日"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "25日"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "26日"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "27日"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "28日"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "29日"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "30日"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Name}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Month}}{{.Day}}"
+  }
+}`,
+	"ko": `{
+  "month.long.1": {
+    "description": "Full name of January",
+    "other": "1월"
+  },
+  "month.long.2": {
+    "description": "Full name of February",
+    "other": "2월"
+  },
+  "month.long.3": {
+    "description": "Full name of March",
+    "other": "3월"
+  },
+  "month.long.4": {
+    "description": "Full name of April",
+    "other": "4월"
+  },
+  "month.long.5": {
+    "description": "Full name of May",
+    "other": "5월"
+  },
+  "month.long.6": {
+    "description": "Full name of June",
+    "other": "6월"
+  },
+  "month.long.7": {
+    "description": "Full name of July",
+    "other": "7월"
+  },
+  "month.long.8": {
+    "description": "Full name of August",
+    "other": "8월"
+  },
+  "month.long.9": {
+    "description": "Full name of September",
+    "other": "9월"
+  },
+  "month.long.10": {
+    "description": "Full name of October",
+    "other": "10월"
+  },
+  "month.long.11": {
+    "description": "Full name of November",
+    "other": "11월"
+  },
+  "month.long.12": {
+    "description": "Full name of December",
+    "other": "12월"
+  },
+  "month.short.1": {
+    "description": "Short name of January",
+    "other": "1월"
+  },
+  "month.short.2": {
+    "description": "Short name of February",
+    "other": "2월"
+  },
+  "month.short.3": {
+    "description": "Short name of March",
+    "other": "3월"
+  },
+  "month.short.4": {
+    "description": "Short name of April",
+    "other": "4월"
+  },
+  "month.short.5": {
+    "description": "Short name of May",
+    "other": "5월"
+  },
+  "month.short.6": {
+    "description": "Short name of June",
+    "other": "6월"
+  },
+  "month.short.7": {
+    "description": "Short name of July",
+    "other": "7월"
+  },
+  "month.short.8": {
+    "description": "Short name of August",
+    "other": "8월"
+  },
+  "month.short.9": {
+    "description": "Short name of September",
+    "other": "9월"
+  },
+  "month.short.10": {
+    "description": "Short name of October",
+    "other": "10월"
+  },
+  "month.short.11": {
+    "description": "Short name of November",
+    "other": "11월"
+  },
+  "month.short.12": {
+    "description": "Short name of December",
+    "other": "12월"
+  },
+  "weekday.long.0": {
+    "description": "Full name of Sunday",
+    "other": "일요일"
+  },
+  "weekday.long.1": {
+    "description": "Full name of Monday",
+    "other": "월요일"
+  },
+  "weekday.long.2": {
+    "description": "Full name of Tuesday",
+    "other": "화요일"
+  },
+  "weekday.long.3": {
+    "description": "Full name of Wednesday",
+    "other": "수요일"
+  },
+  "weekday.long.4": {
+    "description": "Full name of Thursday",
+    "other": "목요일"
+  },
+  "weekday.long.5": {
+    "description": "Full name of Friday",
+    "other": "금요일"
+  },
+  "weekday.long.6": {
+    "description": "Full name of Saturday",
+    "other": "토요일"
+  },
+  "weekday.short.0": {
+    "description": "Short name of Sunday",
+    "other": "일"
+  },
+  "weekday.short.1": {
+    "description": "Short name of Monday",
+    "other": "월"
+  },
+  "weekday.short.2": {
+    "description": "Short name of Tuesday",
+    "other": "화"
+  },
+  "weekday.short.3": {
+    "description": "Short name of Wednesday",
+    "other": "수"
+  },
+  "weekday.short.4": {
+    "description": "Short name of Thursday",
+    "other": "목"
+  },
+  "weekday.short.5": {
+    "description": "Short name of Friday",
+    "other": "금"
+  },
+  "weekday.short.6": {
+    "description": "Short name of Saturday",
+    "other": "토"
+  },
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "지금"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "other": "{{.Count}}분 전"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "other": "{{.Count}}시간 전"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "other": "{{.Count}}일 전"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "other": "{{.Count}}주 전"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "other": "{{.Count}}개월 전"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "other": "{{.Count}}년 전"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "other": "{{.Count}}분 후"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "other": "{{.Count}}시간 후"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "other": "{{.Count}}일 후"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "other": "{{.Count}}주 후"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "other": "{{.Count}}개월 후"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "other": "{{.Count}}년 후"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "other": "{{.Count}}일"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "other": "{{.Count}}시간"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "other": "{{.Count}}분"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "other": "{{.Count}}초"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}} {{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}, {{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}} 및 {{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": ", "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "{{.From}}부터 {{.To}}까지"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "{{.Year}}년 {{.Quarter}}분기"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{if .Year}}{{.Year}}년 {{end}}{{.Month}} {{.Day}}일"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}년"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{if .Year}}{{.Year}}년 {{end}}{{.Month}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Date}} {{.Weekday}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "오전 {{.Hour}}시{{if .Minute}} {{.Minute}}분{{end}}"
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "오후 {{.Hour}}시{{if .Minute}} {{.Minute}}분{{end}}"
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "매일"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "{{.Count}}일마다"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "매주"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "{{.Count}}주마다"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "매월"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "{{.Count}}개월마다"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "매년"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "{{.Count}}년마다"
+  },
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "매주 {{.Days}}"
+  },
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "{{.Count}}주마다 {{.Days}}"
+  },
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}} {{.Days}}"
+  },
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "{{.Day}}일"
+  },
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "마지막 날"
+  },
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "{{.Nth}} {{.Weekday}}"
+  },
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "첫째"
+  },
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "둘째"
+  },
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "셋째"
+  },
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "넷째"
+  },
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "다섯째"
+  },
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "마지막"
+  },
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "1회"
+  },
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "other": "{{.Count}}회"
+  },
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "무하람"
+  },
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "사파르"
+  },
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "라비 알 아왈"
+  },
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "라비 알 쎄니"
+  },
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "주마다 알 아왈"
+  },
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "주마다 알 쎄니"
+  },
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "라잡"
+  },
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "쉐아반"
+  },
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "라마단"
+  },
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "쉐왈"
+  },
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "듀 알 까다"
+  },
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "듀 알 히자"
+  },
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "무하람"
+  },
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "사파르"
+  },
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "라비 알 아왈"
+  },
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "라비 알 쎄니"
+  },
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "주마다 알 아왈"
+  },
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "주마다 알 쎄니"
+  },
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "라잡"
+  },
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "쉐아반"
+  },
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "라마단"
+  },
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "쉐왈"
+  },
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "듀 알 까다"
+  },
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "듀 알 히자"
+  },
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "화르바딘"
+  },
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "오르디베헤쉬트"
+  },
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "호르다드"
+  },
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "티르"
+  },
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "모르다드"
+  },
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "샤흐리바르"
+  },
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "메흐르"
+  },
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "아반"
+  },
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "아자르"
+  },
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "다이"
+  },
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "바흐만"
+  },
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "에스판드"
+  },
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "화르바딘"
+  },
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "오르디베헤쉬트"
+  },
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "호르다드"
+  },
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "티르"
+  },
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "모르다드"
+  },
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "샤흐리바르"
+  },
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "메흐르"
+  },
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "아반"
+  },
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "아자르"
+  },
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "다이"
+  },
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "바흐만"
+  },
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "에스판드"
+  },
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "디스리월"
+  },
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "말케스월"
+  },
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "기슬르월"
+  },
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "데벳월"
+  },
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "스밧월"
+  },
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "아달월 1"
+  },
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "아달월"
+  },
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "아달월 2"
+  },
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "닛산월"
+  },
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "이야르월"
+  },
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "시완월"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "담무르월"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "압월"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "엘룰월"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "디스리월"
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "말케스월"
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "기슬르월"
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "데벳월"
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "스밧월"
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "아달월 1"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "아달월"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "아달월 2"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "닛산월"
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "이야르월"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "시완월"
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "담무르월"
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "압월"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "엘룰월"
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1월"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2월"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3월"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4월"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5월"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6월"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7월"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8월"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9월"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10월"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11월"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12월"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1월"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2월"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3월"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4월"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5월"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6월"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7월"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8월"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9월"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10월"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11월"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12월"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "윤{{.Month}}"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1일"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2일"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3일"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4일"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5일"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6일"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7일"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8일"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9일"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10일"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11일"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12일"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "13일"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "14일"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "15일"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "16일"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "17일"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "18일"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "19일"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "20일"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "21일"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "22일"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "23일"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "24일"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "25일"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "26일"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "27일"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "28일"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "29일"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "30일"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Name}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Month}} {{.Day}}"
+  }
+}`,
+	"th": `{
+  "month.long.1": {
+    "description": "Full name of January",
+    "other": "มกราคม"
+  },
+  "month.long.2": {
+    "description": "Full name of February",
+    "other": "กุมภาพันธ์"
+  },
+  "month.long.3": {
+    "description": "Full name of March",
+    "other": "มีนาคม"
+  },
+  "month.long.4": {
+    "description": "Full name of April",
+    "other": "เมษายน"
+  },
+  "month.long.5": {
+    "description": "Full name of May",
+    "other": "พฤษภาคม"
+  },
+  "month.long.6": {
+    "description": "Full name of June",
+    "other": "มิถุนายน"
+  },
+  "month.long.7": {
+    "description": "Full name of July",
+    "other": "กรกฎาคม"
+  },
+  "month.long.8": {
+    "description": "Full name of August",
+    "other": "สิงหาคม"
+  },
+  "month.long.9": {
+    "description": "Full name of September",
+    "other": "กันยายน"
+  },
+  "month.long.10": {
+    "description": "Full name of October",
+    "other": "ตุลาคม"
+  },
+  "month.long.11": {
+    "description": "Full name of November",
+    "other": "พฤศจิกายน"
+  },
+  "month.long.12": {
+    "description": "Full name of December",
+    "other": "ธันวาคม"
+  },
+  "month.short.1": {
+    "description": "Short name of January",
+    "other": "ม.ค."
+  },
+  "month.short.2": {
+    "description": "Short name of February",
+    "other": "ก.พ."
+  },
+  "month.short.3": {
+    "description": "Short name of March",
+    "other": "มี.ค."
+  },
+  "month.short.4": {
+    "description": "Short name of April",
+    "other": "เม.ย."
+  },
+  "month.short.5": {
+    "description": "Short name of May",
+    "other": "พ.ค."
+  },
+  "month.short.6": {
+    "description": "Short name of June",
+    "other": "มิ.ย."
+  },
+  "month.short.7": {
+    "description": "Short name of July",
+    "other": "ก.ค."
+  },
+  "month.short.8": {
+    "description": "Short name of August",
+    "other": "ส.ค."
+  },
+  "month.short.9": {
+    "description": "Short name of September",
+    "other": "ก.ย."
+  },
+  "month.short.10": {
+    "description": "Short name of October",
+    "other": "ต.ค."
+  },
+  "month.short.11": {
+    "description": "Short name of November",
+    "other": "พ.ย."
+  },
+  "month.short.12": {
+    "description": "Short name of December",
+    "other": "ธ.ค."
+  },
+  "weekday.long.0": {
+    "description": "Full name of Sunday",
+    "other": "วันอาทิตย์"
+  },
+  "weekday.long.1": {
+    "description": "Full name of Monday",
+    "other": "วันจันทร์"
+  },
+  "weekday.long.2": {
+    "description": "Full name of Tuesday",
+    "other": "วันอังคาร"
+  },
+  "weekday.long.3": {
+    "description": "Full name of Wednesday",
+    "other": "วันพุธ"
+  },
+  "weekday.long.4": {
+    "description": "Full name of Thursday",
+    "other": "วันพฤหัสบดี"
+  },
+  "weekday.long.5": {
+    "description": "Full name of Friday",
+    "other": "วันศุกร์"
+  },
+  "weekday.long.6": {
+    "description": "Full name of Saturday",
+    "other": "วันเสาร์"
+  },
+  "weekday.short.0": {
+    "description": "Short name of Sunday",
+    "other": "อา."
+  },
+  "weekday.short.1": {
+    "description": "Short name of Monday",
+    "other": "จ."
+  },
+  "weekday.short.2": {
+    "description": "Short name of Tuesday",
+    "other": "อ."
+  },
+  "weekday.short.3": {
+    "description": "Short name of Wednesday",
+    "other": "พ."
+  },
+  "weekday.short.4": {
+    "description": "Short name of Thursday",
+    "other": "พฤ."
+  },
+  "weekday.short.5": {
+    "description": "Short name of Friday",
+    "other": "ศ."
+  },
+  "weekday.short.6": {
+    "description": "Short name of Saturday",
+    "other": "ส."
+  },
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "ขณะนี้"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "other": "{{.Count}} นาทีที่ผ่านมา"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "other": "{{.Count}} ชั่วโมงที่ผ่านมา"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "other": "{{.Count}} วันที่ผ่านมา"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "other": "{{.Count}} สัปดาห์ที่ผ่านมา"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "other": "{{.Count}} เดือนที่ผ่านมา"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "other": "{{.Count}} ปีที่แล้ว"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "other": "ในอีก {{.Count}} นาที"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "other": "ในอีก {{.Count}} ชั่วโมง"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "other": "ในอีก {{.Count}} วัน"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "other": "ในอีก {{.Count}} สัปดาห์"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "other": "ในอีก {{.Count}} เดือน"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "other": "ในอีก {{.Count}} ปี"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "other": "{{.Count}} วัน"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "other": "{{.Count}} ชั่วโมง"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "other": "{{.Count}} นาที"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "other": "{{.Count}} วินาที"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}} {{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}, {{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}} และ {{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": ", "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "{{.From}} ถึง {{.To}}"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "ไตรมาส {{.Quarter}} ปี {{.Year}}"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{.Day}} {{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Weekday}}ที่ {{.Date}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "{{.Time}} ก่อนเที่ยง"
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "{{.Time}} หลังเที่ยง"
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "ทุกวัน"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "ทุก {{.Count}} วัน"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "ทุกสัปดาห์"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "ทุก {{.Count}} สัปดาห์"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "ทุกเดือน"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "ทุก {{.Count}} เดือน"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "ทุกปี"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "ทุก {{.Count}} ปี"
+  },
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "ทุก{{.Days}}"
+  },
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "ทุก {{.Count}} สัปดาห์ใน{{.Days}}"
+  },
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}} ใน{{.Days}}"
+  },
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "วันที่ {{.Day}}"
+  },
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "วันสุดท้ายของเดือน"
+  },
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "{{.Weekday}}{{.Nth}}"
+  },
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "แรก"
+  },
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "ที่สอง"
+  },
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "ที่สาม"
+  },
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "ที่สี่"
+  },
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "ที่ห้า"
+  },
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "สุดท้าย"
+  },
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "1 ครั้ง"
+  },
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "other": "{{.Count}} ครั้ง"
+  },
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "มุฮะร์รอม"
+  },
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "ซอฟาร์"
+  },
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "รอบี I"
+  },
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "รอบี II"
+  },
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "จุมาดา I"
+  },
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "จุมาดา II"
+  },
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "รอจับ"
+  },
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "ชะอะบาน"
+  },
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "รอมะดอน"
+  },
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "เชาวัล"
+  },
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "ซุลกิอฺดะฮฺ"
+  },
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "ซุลหิจญะฮฺ"
+  },
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "มุฮัร."
+  },
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "เศาะ."
+  },
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "รอบี I"
+  },
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "รอบี II"
+  },
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "จุมาดา I"
+  },
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "จุมาดา II"
+  },
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "เราะ."
+  },
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "ชะอ์."
+  },
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "เราะมะ."
+  },
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "เชาว."
+  },
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "ซุลกิอฺ."
+  },
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "ซุลหิจ."
+  },
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "ฟาร์วาร์ดิน"
+  },
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "ออร์ดิเบเฮชต์"
+  },
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "คอร์แดด"
+  },
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "เตอร์"
+  },
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "มอร์แดด"
+  },
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "ชาหริวาร์"
+  },
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "เมฮร์"
+  },
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "อะบาน"
+  },
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "อะซาร์"
+  },
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "เดย์"
+  },
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "บาฮ์มาน"
+  },
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "เอสฟานด์"
+  },
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "ฟาร์วาร์ดิน"
+  },
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "ออร์ดิเบเฮชต์"
+  },
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "คอร์แดด"
+  },
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "เตอร์"
+  },
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "มอร์แดด"
+  },
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "ชาหริวาร์"
+  },
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "เมฮร์"
+  },
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "อะบาน"
+  },
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "อะซาร์"
+  },
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "เดย์"
+  },
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "บาฮ์มาน"
+  },
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "เอสฟานด์"
+  },
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "ทิชรี"
+  },
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "เฮวาน"
+  },
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "กีสเลฟ"
+  },
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "เตเวต"
+  },
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "เชวัต"
+  },
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "อาดาร์ I"
+  },
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "อาดาร์"
+  },
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "อาดาร์ II"
+  },
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "นิสซาน"
+  },
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "อิยาร์"
+  },
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "สีวัน"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "ตามูซ"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "อัฟ"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "เอลอุล"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "ทิชรี"
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "เฮวาน"
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "กีสเลฟ"
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "เตเวต"
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "เชวัต"
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "อาดาร์ I"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "อาดาร์"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "อาดาร์ II"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "นิสซาน"
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "อิยาร์"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "สีวัน"
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "ตามูซ"
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "อัฟ"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "เอลอุล"
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนอ้าย"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนยี่"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนสาม"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนสี่"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนห้า"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนหก"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนเจ็ด"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนแปด"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนเก้า"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนสิบ"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนสิบเอ็ด"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนสิบสอง"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนอ้าย"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนยี่"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนสาม"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนสี่"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนห้า"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนหก"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนเจ็ด"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนแปด"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนเก้า"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนสิบ"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนสิบเอ็ด"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "เดือนสิบสอง"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "{{.Month}} (อธิกมาส)"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "13"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "14"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "15"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "16"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "17"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "18"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "19"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "20"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "21"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "22"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "23"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "24"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "25"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "26"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "27"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "28"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "29"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "30"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Day}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Month}} {{.Day}}"
+  }
+}`,
+	"ur": `{
+  "month.long.1": {
+    "description": "Full name of January",
+    "other": "جنوری"
+  },
+  "month.long.2": {
+    "description": "Full name of February",
+    "other": "فروری"
+  },
+  "month.long.3": {
+    "description": "Full name of March",
+    "other": "مارچ"
+  },
+  "month.long.4": {
+    "description": "Full name of April",
+    "other": "اپریل"
+  },
+  "month.long.5": {
+    "description": "Full name of May",
+    "other": "مئی"
+  },
+  "month.long.6": {
+    "description": "Full name of June",
+    "other": "جون"
+  },
+  "month.long.7": {
+    "description": "Full name of July",
+    "other": "جولائی"
+  },
+  "month.long.8": {
+    "description": "Full name of August",
+    "other": "اگست"
+  },
+  "month.long.9": {
+    "description": "Full name of September",
+    "other": "ستمبر"
+  },
+  "month.long.10": {
+    "description": "Full name of October",
+    "other": "اکتوبر"
+  },
+  "month.long.11": {
+    "description": "Full name of November",
+    "other": "نومبر"
+  },
+  "month.long.12": {
+    "description": "Full name of December",
+    "other": "دسمبر"
+  },
+  "month.short.1": {
+    "description": "Short name of January",
+    "other": "جنوری"
+  },
+  "month.short.2": {
+    "description": "Short name of February",
+    "other": "فروری"
+  },
+  "month.short.3": {
+    "description": "Short name of March",
+    "other": "مارچ"
+  },
+  "month.short.4": {
+    "description": "Short name of April",
+    "other": "اپریل"
+  },
+  "month.short.5": {
+    "description": "Short name of May",
+    "other": "مئی"
+  },
+  "month.short.6": {
+    "description": "Short name of June",
+    "other": "جون"
+  },
+  "month.short.7": {
+    "description": "Short name of July",
+    "other": "جولائی"
+  },
+  "month.short.8": {
+    "description": "Short name of August",
+    "other": "اگست"
+  },
+  "month.short.9": {
+    "description": "Short name of September",
+    "other": "ستمبر"
+  },
+  "month.short.10": {
+    "description": "Short name of October",
+    "other": "اکتوبر"
+  },
+  "month.short.11": {
+    "description": "Short name of November",
+    "other": "نومبر"
+  },
+  "month.short.12": {
+    "description": "Short name of December",
+    "other": "دسمبر"
+  },
+  "weekday.long.0": {
+    "description": "Full name of Sunday",
+    "other": "اتوار"
+  },
+  "weekday.long.1": {
+    "description": "Full name of Monday",
+    "other": "پیر"
+  },
+  "weekday.long.2": {
+    "description": "Full name of Tuesday",
+    "other": "منگل"
+  },
+  "weekday.long.3": {
+    "description": "Full name of Wednesday",
+    "other": "بدھ"
+  },
+  "weekday.long.4": {
+    "description": "Full name of Thursday",
+    "other": "جمعرات"
+  },
+  "weekday.long.5": {
+    "description": "Full name of Friday",
+    "other": "جمعہ"
+  },
+  "weekday.long.6": {
+    "description": "Full name of Saturday",
+    "other": "ہفتہ"
+  },
+  "weekday.short.0": {
+    "description": "Short name of Sunday",
+    "other": "اتوار"
+  },
+  "weekday.short.1": {
+    "description": "Short name of Monday",
+    "other": "پیر"
+  },
+  "weekday.short.2": {
+    "description": "Short name of Tuesday",
+    "other": "منگل"
+  },
+  "weekday.short.3": {
+    "description": "Short name of Wednesday",
+    "other": "بدھ"
+  },
+  "weekday.short.4": {
+    "description": "Short name of Thursday",
+    "other": "جمعرات"
+  },
+  "weekday.short.5": {
+    "description": "Short name of Friday",
+    "other": "جمعہ"
+  },
+  "weekday.short.6": {
+    "description": "Short name of Saturday",
+    "other": "ہفتہ"
+  },
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "ابھی"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "one": "{{.Count}} منٹ پہلے",
+    "other": "{{.Count}} منٹ پہلے"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "one": "{{.Count}} گھنٹہ پہلے",
+    "other": "{{.Count}} گھنٹے پہلے"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "one": "{{.Count}} دن پہلے",
+    "other": "{{.Count}} دن پہلے"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "one": "{{.Count}} ہفتہ پہلے",
+    "other": "{{.Count}} ہفتے پہلے"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "one": "{{.Count}} مہینہ پہلے",
+    "other": "{{.Count}} مہینے پہلے"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "one": "{{.Count}} سال پہلے",
+    "other": "{{.Count}} سال پہلے"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "one": "{{.Count}} منٹ میں",
+    "other": "{{.Count}} منٹ میں"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "one": "{{.Count}} گھنٹہ میں",
+    "other": "{{.Count}} گھنٹے میں"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "one": "{{.Count}} دن میں",
+    "other": "{{.Count}} دن میں"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "one": "{{.Count}} ہفتہ میں",
+    "other": "{{.Count}} ہفتے میں"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "one": "{{.Count}} مہینہ میں",
+    "other": "{{.Count}} مہینے میں"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "one": "{{.Count}} سال میں",
+    "other": "{{.Count}} سال میں"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "one": "{{.Count}} دن",
+    "other": "{{.Count}} دن"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "one": "{{.Count}} گھنٹہ",
+    "other": "{{.Count}} گھنٹے"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "one": "{{.Count}} منٹ",
+    "other": "{{.Count}} منٹ"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "one": "{{.Count}} سیکنڈ",
+    "other": "{{.Count}} سیکنڈ"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}} {{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}، {{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}} اور {{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": "، "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "{{.From}} سے {{.To}} تک"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "سہ ماہی {{.Quarter}}، {{.Year}}"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{.Day}} {{.Month}}{{if .Year}}، {{.Year}}{{end}}"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{.Month}}{{if .Year}} {{.Year}}{{end}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Weekday}}، {{.Date}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "{{.Time}} AM"
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "{{.Time}} PM"
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "روزانہ"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "ہر {{.Count}} دن"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "ہفتہ وار"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "ہر {{.Count}} ہفتے"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "ماہانہ"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "ہر {{.Count}} مہینے"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "سالانہ"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "ہر {{.Count}} سال"
+  },
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "ہر {{.Days}}"
+  },
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "ہر {{.Count}} ہفتے {{.Days}} کو"
+  },
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}} {{.Days}} کو"
+  },
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "دن {{.Day}}"
+  },
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "آخری دن"
+  },
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "{{.Nth}} {{.Weekday}}"
+  },
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "پہلا"
+  },
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "دوسرا"
+  },
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "تیسرا"
+  },
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "چوتھا"
+  },
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "پانچواں"
+  },
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "آخری"
+  },
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "ایک بار"
+  },
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "other": "{{.Count}} بار"
+  },
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "محرم"
+  },
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "صفر"
+  },
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "ربیع الاول"
+  },
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "ربیع الثانی"
+  },
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "جمادی الاول"
+  },
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "جمادی الثانی"
+  },
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "رجب"
+  },
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "شعبان"
+  },
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "رمضان"
+  },
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "شوال"
+  },
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "ذوالقعدہ"
+  },
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "ذوالحجہ"
+  },
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "محرم"
+  },
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "صفر"
+  },
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "ربیع الاول"
+  },
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "ربیع الثانی"
+  },
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "جمادی الاول"
+  },
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "جمادی الثانی"
+  },
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "رجب"
+  },
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "شعبان"
+  },
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "رمضان"
+  },
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "شوال"
+  },
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "ذوالقعدہ"
+  },
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "ذوالحجہ"
+  },
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "فروردین"
+  },
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "اردیبہشت"
+  },
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "خرداد"
+  },
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "تیر"
+  },
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "مرداد"
+  },
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "شہریور"
+  },
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "مہر"
+  },
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "آبان"
+  },
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "آذر"
+  },
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "دی"
+  },
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "بہمن"
+  },
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "اسفند"
+  },
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "فروردین"
+  },
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "اردیبہشت"
+  },
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "خرداد"
+  },
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "تیر"
+  },
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "مرداد"
+  },
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "شہریور"
+  },
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "مہر"
+  },
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "آبان"
+  },
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "آذر"
+  },
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "دی"
+  },
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "بہمن"
+  },
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "اسفند"
+  },
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "تشری"
+  },
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "حشوان"
+  },
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "کسلیو"
+  },
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "طیبت"
+  },
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "شباط"
+  },
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "آدر اوّل"
+  },
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "آدر"
+  },
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "آدر دوّم"
+  },
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "نیسان"
+  },
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "ایار"
+  },
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "سیوان"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "تموز"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "آب"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "ایلول"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "تشری"
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "حشوان"
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "کسلیو"
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "طیبت"
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "شباط"
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "آدر اوّل"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "آدر"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "آدر دوّم"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "نیسان"
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "ایار"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "سیوان"
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "تموز"
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "آب"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "ایلول"
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M01"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M02"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M03"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M04"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M05"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M06"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M07"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M08"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M09"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M10"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M11"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M12"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M01"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M02"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M03"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M04"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M05"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M06"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M07"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M08"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M09"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M10"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M11"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "M12"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "{{.Month}} لیپ"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "1"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "2"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "3"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "4"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "5"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "6"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "7"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "8"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "9"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "10"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "13"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "14"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "15"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "16"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "17"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "18"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "19"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "20"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "21"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "22"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "23"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "24"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "25"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "26"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "27"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "28"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "29"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "30"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Day}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Month}} {{.Day}}"
+  }
+}`,
+	"vi": `{
+  "month.long.1": {
+    "description": "Full name of January",
+    "other": "Tháng Một"
+  },
+  "month.long.2": {
+    "description": "Full name of February",
+    "other": "Tháng Hai"
+  },
+  "month.long.3": {
+    "description": "Full name of March",
+    "other": "Tháng Ba"
+  },
+  "month.long.4": {
+    "description": "Full name of April",
+    "other": "Tháng Tư"
+  },
+  "month.long.5": {
+    "description": "Full name of May",
+    "other": "Tháng Năm"
+  },
+  "month.long.6": {
+    "description": "Full name of June",
+    "other": "Tháng Sáu"
+  },
+  "month.long.7": {
+    "description": "Full name of July",
+    "other": "Tháng Bảy"
+  },
+  "month.long.8": {
+    "description": "Full name of August",
+    "other": "Tháng Tám"
+  },
+  "month.long.9": {
+    "description": "Full name of September",
+    "other": "Tháng Chín"
+  },
+  "month.long.10": {
+    "description": "Full name of October",
+    "other": "Tháng Mười"
+  },
+  "month.long.11": {
+    "description": "Full name of November",
+    "other": "Tháng Mười Một"
+  },
+  "month.long.12": {
+    "description": "Full name of December",
+    "other": "Tháng Mười Hai"
+  },
+  "month.short.1": {
+    "description": "Short name of January",
+    "other": "Th1"
+  },
+  "month.short.2": {
+    "description": "Short name of February",
+    "other": "Th2"
+  },
+  "month.short.3": {
+    "description": "Short name of March",
+    "other": "Th3"
+  },
+  "month.short.4": {
+    "description": "Short name of April",
+    "other": "Th4"
+  },
+  "month.short.5": {
+    "description": "Short name of May",
+    "other": "Th5"
+  },
+  "month.short.6": {
+    "description": "Short name of June",
+    "other": "Th6"
+  },
+  "month.short.7": {
+    "description": "Short name of July",
+    "other": "Th7"
+  },
+  "month.short.8": {
+    "description": "Short name of August",
+    "other": "Th8"
+  },
+  "month.short.9": {
+    "description": "Short name of September",
+    "other": "Th9"
+  },
+  "month.short.10": {
+    "description": "Short name of October",
+    "other": "Th10"
+  },
+  "month.short.11": {
+    "description": "Short name of November",
+    "other": "Th11"
+  },
+  "month.short.12": {
+    "description": "Short name of December",
+    "other": "Th12"
+  },
+  "weekday.long.0": {
+    "description": "Full name of Sunday",
+    "other": "Chủ Nhật"
+  },
+  "weekday.long.1": {
+    "description": "Full name of Monday",
+    "other": "Thứ Hai"
+  },
+  "weekday.long.2": {
+    "description": "Full name of Tuesday",
+    "other": "Thứ Ba"
+  },
+  "weekday.long.3": {
+    "description": "Full name of Wednesday",
+    "other": "Thứ Tư"
+  },
+  "weekday.long.4": {
+    "description": "Full name of Thursday",
+    "other": "Thứ Năm"
+  },
+  "weekday.long.5": {
+    "description": "Full name of Friday",
+    "other": "Thứ Sáu"
+  },
+  "weekday.long.6": {
+    "description": "Full name of Saturday",
+    "other": "Thứ Bảy"
+  },
+  "weekday.short.0": {
+    "description": "Short name of Sunday",
+    "other": "CN"
+  },
+  "weekday.short.1": {
+    "description": "Short name of Monday",
+    "other": "T2"
+  },
+  "weekday.short.2": {
+    "description": "Short name of Tuesday",
+    "other": "T3"
+  },
+  "weekday.short.3": {
+    "description": "Short name of Wednesday",
+    "other": "T4"
+  },
+  "weekday.short.4": {
+    "description": "Short name of Thursday",
+    "other": "T5"
+  },
+  "weekday.short.5": {
+    "description": "Short name of Friday",
+    "other": "T6"
+  },
+  "weekday.short.6": {
+    "description": "Short name of Saturday",
+    "other": "T7"
+  },
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "bây giờ"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "other": "{{.Count}} phút trước"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "other": "{{.Count}} giờ trước"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "other": "{{.Count}} ngày trước"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "other": "{{.Count}} tuần trước"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "other": "{{.Count}} tháng trước"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "other": "{{.Count}} năm trước"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "other": "sau {{.Count}} phút"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "other": "sau {{.Count}} giờ"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "other": "sau {{.Count}} ngày"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "other": "sau {{.Count}} tuần"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "other": "sau {{.Count}} tháng"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "other": "sau {{.Count}} năm"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "other": "{{.Count}} ngày"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "other": "{{.Count}} giờ"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "other": "{{.Count}} phút"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "other": "{{.Count}} giây"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}} {{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}, {{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}} và {{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": ", "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "{{.From}} đến {{.To}}"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "Quý {{.Quarter}} năm {{.Year}}"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{.Day}} {{.Month}}{{if .Year}}, {{.Year}}{{end}}"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{.Month}}{{if .Year}} năm {{.Year}}{{end}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Weekday}}, {{.Date}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "{{.Time}} SA"
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "{{.Time}} CH"
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "Hằng ngày"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "Mỗi {{.Count}} ngày"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "Hằng tuần"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "Mỗi {{.Count}} tuần"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "Hằng tháng"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "Mỗi {{.Count}} tháng"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "Hằng năm"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "Mỗi {{.Count}} năm"
+  },
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "Mỗi {{.Days}}"
+  },
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "Mỗi {{.Count}} tuần vào {{.Days}}"
+  },
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}} vào {{.Days}}"
+  },
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "ngày {{.Day}}"
+  },
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "ngày cuối cùng"
+  },
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "{{.Weekday}} {{.Nth}}"
+  },
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "đầu tiên"
+  },
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "thứ 2"
+  },
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "thứ 3"
+  },
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "thứ 4"
+  },
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "thứ 5"
+  },
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "cuối cùng"
+  },
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "1 lần"
+  },
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "other": "{{.Count}} lần"
+  },
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "Muharram"
+  },
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "Safar"
+  },
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "Rabiʻ I"
+  },
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "Rabiʻ II"
+  },
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "Jumada I"
+  },
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "Jumada II"
+  },
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "Rajab"
+  },
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "Shaʻban"
+  },
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "Ramadan"
+  },
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "Shawwal"
+  },
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "Dhuʻl-Qiʻdah"
+  },
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "Dhuʻl-Hijjah"
+  },
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "Muh."
+  },
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "Saf."
+  },
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "Rab. I"
+  },
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "Rab. II"
+  },
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "Jum. I"
+  },
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "Jum. II"
+  },
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "Raj."
+  },
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "Sha."
+  },
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "Ram."
+  },
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "Shaw."
+  },
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "Dhuʻl-Q."
+  },
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "Dhuʻl-H."
+  },
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "Farvardin"
+  },
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "Ordibehesht"
+  },
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "Khordad"
+  },
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "Tir"
+  },
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "Mordad"
+  },
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "Shahrivar"
+  },
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "Mehr"
+  },
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "Aban"
+  },
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "Azar"
+  },
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "Dey"
+  },
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "Bahman"
+  },
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "Esfand"
+  },
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "Farvardin"
+  },
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "Ordibehesht"
+  },
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "Khordad"
+  },
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "Tir"
+  },
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "Mordad"
+  },
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "Shahrivar"
+  },
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "Mehr"
+  },
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "Aban"
+  },
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "Azar"
+  },
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "Dey"
+  },
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "Bahman"
+  },
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "Esfand"
+  },
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "Tishri"
+  },
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "Heshvan"
+  },
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "Kislev"
+  },
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "Tevet"
+  },
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "Shevat"
+  },
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "Adar I"
+  },
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "Adar"
+  },
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "Adar II"
+  },
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "Nisan"
+  },
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "Iyar"
+  },
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "Sivan"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "Tamuz"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "Av"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "Elul"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "Tishri"
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "Heshvan"
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "Kislev"
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "Tevet"
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "Shevat"
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "Adar I"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "Adar"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "Adar II"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "Nisan"
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "Iyar"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "Sivan"
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "Tamuz"
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "Av"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "Elul"
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng Giêng"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng Hai"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng Ba"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng Tư"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng Năm"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng Sáu"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng Bảy"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng Tám"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng Chín"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng Mười"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng Mười Một"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng Chạp"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Tết"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng 2"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng 3"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng 4"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng 5"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng 6"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng 7"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng 8"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng 9"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng 10"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng 11"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "tháng Chạp"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "{{.Month}} nhuận"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mùng 1"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mùng 2"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mùng 3"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mùng 4"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mùng 5"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mùng 6"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mùng 7"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mùng 8"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mùng 9"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "Mùng 10"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "11"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "12"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "13"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "14"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "15"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "16"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "17"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "18"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "19"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "20"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "21"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "22"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "23"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "24"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "25"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "26"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "27"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "28"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "29"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "30"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Day}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Day}} {{.Month}}"
+  }
+}`,
+	"zh-CN": `{
+  "month.long.1": {
+    "description": "Full name of January",
+    "other": "一月"
+  },
+  "month.long.2": {
+    "description": "Full name of February",
+    "other": "二月"
+  },
+  "month.long.3": {
+    "description": "Full name of March",
+    "other": "三月"
+  },
+  "month.long.4": {
+    "description": "Full name of April",
+    "other": "四月"
+  },
+  "month.long.5": {
+    "description": "Full name of May",
+    "other": "五月"
+  },
+  "month.long.6": {
+    "description": "Full name of June",
+    "other": "六月"
+  },
+  "month.long.7": {
+    "description": "Full name of July",
+    "other": "七月"
+  },
+  "month.long.8": {
+    "description": "Full name of August",
+    "other": "八月"
+  },
+  "month.long.9": {
+    "description": "Full name of September",
+    "other": "九月"
+  },
+  "month.long.10": {
+    "description": "Full name of October",
+    "other": "十月"
+  },
+  "month.long.11": {
+    "description": "Full name of November",
+    "other": "十一月"
+  },
+  "month.long.12": {
+    "description": "Full name of December",
+    "other": "十二月"
+  },
+  "month.short.1": {
+    "description": "Short name of January",
+    "other": "1月"
+  },
+  "month.short.2": {
+    "description": "Short name of February",
+    "other": "2月"
+  },
+  "month.short.3": {
+    "description": "Short name of March",
+    "other": "3月"
+  },
+  "month.short.4": {
+    "description": "Short name of April",
+    "other": "4月"
+  },
+  "month.short.5": {
+    "description": "Short name of May",
+    "other": "5月"
+  },
+  "month.short.6": {
+    "description": "Short name of June",
+    "other": "6月"
+  },
+  "month.short.7": {
+    "description": "Short name of July",
+    "other": "7月"
+  },
+  "month.short.8": {
+    "description": "Short name of August",
+    "other": "8月"
+  },
+  "month.short.9": {
+    "description": "Short name of September",
+    "other": "9月"
+  },
+  "month.short.10": {
+    "description": "Short name of October",
+    "other": "10月"
+  },
+  "month.short.11": {
+    "description": "Short name of November",
+    "other": "11月"
+  },
+  "month.short.12": {
+    "description": "Short name of December",
+    "other": "12月"
+  },
+  "weekday.long.0": {
+    "description": "Full name of Sunday",
+    "other": "星期日"
+  },
+  "weekday.long.1": {
+    "description": "Full name of Monday",
+    "other": "星期一"
+  },
+  "weekday.long.2": {
+    "description": "Full name of Tuesday",
+    "other": "星期二"
+  },
+  "weekday.long.3": {
+    "description": "Full name of Wednesday",
+    "other": "星期三"
+  },
+  "weekday.long.4": {
+    "description": "Full name of Thursday",
+    "other": "星期四"
+  },
+  "weekday.long.5": {
+    "description": "Full name of Friday",
+    "other": "星期五"
+  },
+  "weekday.long.6": {
+    "description": "Full name of Saturday",
+    "other": "星期六"
+  },
+  "weekday.short.0": {
+    "description": "Short name of Sunday",
+    "other": "日"
+  },
+  "weekday.short.1": {
+    "description": "Short name of Monday",
+    "other": "一"
+  },
+  "weekday.short.2": {
+    "description": "Short name of Tuesday",
+    "other": "二"
+  },
+  "weekday.short.3": {
+    "description": "Short name of Wednesday",
+    "other": "三"
+  },
+  "weekday.short.4": {
+    "description": "Short name of Thursday",
+    "other": "四"
+  },
+  "weekday.short.5": {
+    "description": "Short name of Friday",
+    "other": "五"
+  },
+  "weekday.short.6": {
+    "description": "Short name of Saturday",
+    "other": "六"
+  },
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "现在"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "other": "{{.Count}}分钟前"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "other": "{{.Count}}小时前"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "other": "{{.Count}}天前"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "other": "{{.Count}}周前"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "other": "{{.Count}}个月前"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "other": "{{.Count}}年前"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "other": "{{.Count}}分钟后"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "other": "{{.Count}}小时后"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "other": "{{.Count}}天后"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "other": "{{.Count}}周后"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "other": "{{.Count}}个月后"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "other": "{{.Count}}年后"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "other": "{{.Count}}天"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "other": "{{.Count}}小时"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "other": "{{.Count}}分钟"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "other": "{{.Count}}秒"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}}{{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}、{{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}}和{{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": ", "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "{{.From}}至{{.To}}"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "{{.Year}}年第{{.Quarter}}季度"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{if .Year}}{{.Year}}年{{end}}{{.Month}}{{.Day}}日"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}年"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{if .Year}}{{.Year}}年{{end}}{{.Month}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Date}}{{.Weekday}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "上午{{.Hour}}点{{if .Minute}}{{.Minute}}分{{end}}"
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "下午{{.Hour}}点{{if .Minute}}{{.Minute}}分{{end}}"
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "每天"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "每{{.Count}}天"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "每周"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "每{{.Count}}周"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "每月"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "每{{.Count}}个月"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "每年"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "每{{.Count}}年"
+  },
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "每{{.Days}}"
+  },
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "每{{.Count}}周的{{.Days}}"
+  },
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}}{{.Days}}"
+  },
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "{{.Day}}日"
+  },
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "最后一天"
+  },
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "{{.Nth}}{{.Weekday}}"
+  },
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "第1个"
+  },
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "第2个"
+  },
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "第3个"
+  },
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "第4个"
+  },
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "第5个"
+  },
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "最后一个"
+  },
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "1次"
+  },
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "other": "{{.Count}}次"
+  },
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "一月"
+  },
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "二月"
+  },
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "三月"
+  },
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "四月"
+  },
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "五月"
+  },
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "六月"
+  },
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "七月"
+  },
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "八月"
+  },
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "九月"
+  },
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "十月"
+  },
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "十一月"
+  },
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "十二月"
+  },
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "1月"
+  },
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "2月"
+  },
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "3月"
+  },
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "4月"
+  },
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "5月"
+  },
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "6月"
+  },
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "7月"
+  },
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "8月"
+  },
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "9月"
+  },
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "10月"
+  },
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "11月"
+  },
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "12月"
+  },
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "一月"
+  },
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "二月"
+  },
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "三月"
+  },
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "四月"
+  },
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "五月"
+  },
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "六月"
+  },
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "七月"
+  },
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "八月"
+  },
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "九月"
+  },
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "十月"
+  },
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "十一月"
+  },
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "十二月"
+  },
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "1月"
+  },
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "2月"
+  },
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "3月"
+  },
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "4月"
+  },
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "5月"
+  },
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "6月"
+  },
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "7月"
+  },
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "8月"
+  },
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "9月"
+  },
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "10月"
+  },
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "11月"
+  },
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "12月"
+  },
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "提斯利月"
+  },
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "玛西班月"
+  },
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "基斯流月"
+  },
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "提别月"
+  },
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "细罢特月"
+  },
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "亚达月 I"
+  },
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "亚达月"
+  },
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "亚达月 II"
+  },
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "尼散月"
+  },
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "以珥月"
+  },
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "西弯月"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "搭模斯月"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "埃波月"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "以禄月"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "提斯利月"
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "玛西班月"
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "基斯流月"
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "提别月"
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "细罢特月"
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "亚达月 I"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "亚达月"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "亚达月 II"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "尼散月"
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "以珥月"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "西弯月"
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "搭模斯月"
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "埃波月"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "以禄月"
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "正月"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "二月"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "三月"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "四月"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "五月"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "六月"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "七月"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "八月"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "九月"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十月"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "冬月"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "腊月"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "正月"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "二月"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "三月"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "四月"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "五月"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "六月"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "七月"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "八月"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "九月"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十月"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "冬月"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "腊月"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "闰{{.Month}}"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初一"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初二"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初三"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初四"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初五"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初六"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初七"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初八"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初九"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初十"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十一"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十二"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十三"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十四"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十五"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十六"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十七"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十八"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十九"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "二十"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿一"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿二"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿三"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿四"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿五"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿六"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿七"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿八"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿九"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "三十"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Name}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Month}}{{.Day}}"
+  }
+}`,
+	"zh-TW": `{
+  "month.long.1": {
+    "description": "Full name of January",
+    "other": "一月"
+  },
+  "month.long.2": {
+    "description": "Full name of February",
+    "other": "二月"
+  },
+  "month.long.3": {
+    "description": "Full name of March",
+    "other": "三月"
+  },
+  "month.long.4": {
+    "description": "Full name of April",
+    "other": "四月"
+  },
+  "month.long.5": {
+    "description": "Full name of May",
+    "other": "五月"
+  },
+  "month.long.6": {
+    "description": "Full name of June",
+    "other": "六月"
+  },
+  "month.long.7": {
+    "description": "Full name of July",
+    "other": "七月"
+  },
+  "month.long.8": {
+    "description": "Full name of August",
+    "other": "八月"
+  },
+  "month.long.9": {
+    "description": "Full name of September",
+    "other": "九月"
+  },
+  "month.long.10": {
+    "description": "Full name of October",
+    "other": "十月"
+  },
+  "month.long.11": {
+    "description": "Full name of November",
+    "other": "十一月"
+  },
+  "month.long.12": {
+    "description": "Full name of December",
+    "other": "十二月"
+  },
+  "month.short.1": {
+    "description": "Short name of January",
+    "other": "1月"
+  },
+  "month.short.2": {
+    "description": "Short name of February",
+    "other": "2月"
+  },
+  "month.short.3": {
+    "description": "Short name of March",
+    "other": "3月"
+  },
+  "month.short.4": {
+    "description": "Short name of April",
+    "other": "4月"
+  },
+  "month.short.5": {
+    "description": "Short name of May",
+    "other": "5月"
+  },
+  "month.short.6": {
+    "description": "Short name of June",
+    "other": "6月"
+  },
+  "month.short.7": {
+    "description": "Short name of July",
+    "other": "7月"
+  },
+  "month.short.8": {
+    "description": "Short name of August",
+    "other": "8月"
+  },
+  "month.short.9": {
+    "description": "Short name of September",
+    "other": "9月"
+  },
+  "month.short.10": {
+    "description": "Short name of October",
+    "other": "10月"
+  },
+  "month.short.11": {
+    "description": "Short name of November",
+    "other": "11月"
+  },
+  "month.short.12": {
+    "description": "Short name of December",
+    "other": "12月"
+  },
+  "weekday.long.0": {
+    "description": "Full name of Sunday",
+    "other": "星期日"
+  },
+  "weekday.long.1": {
+    "description": "Full name of Monday",
+    "other": "星期一"
+  },
+  "weekday.long.2": {
+    "description": "Full name of Tuesday",
+    "other": "星期二"
+  },
+  "weekday.long.3": {
+    "description": "Full name of Wednesday",
+    "other": "星期三"
+  },
+  "weekday.long.4": {
+    "description": "Full name of Thursday",
+    "other": "星期四"
+  },
+  "weekday.long.5": {
+    "description": "Full name of Friday",
+    "other": "星期五"
+  },
+  "weekday.long.6": {
+    "description": "Full name of Saturday",
+    "other": "星期六"
+  },
+  "weekday.short.0": {
+    "description": "Short name of Sunday",
+    "other": "日"
+  },
+  "weekday.short.1": {
+    "description": "Short name of Monday",
+    "other": "一"
+  },
+  "weekday.short.2": {
+    "description": "Short name of Tuesday",
+    "other": "二"
+  },
+  "weekday.short.3": {
+    "description": "Short name of Wednesday",
+    "other": "三"
+  },
+  "weekday.short.4": {
+    "description": "Short name of Thursday",
+    "other": "四"
+  },
+  "weekday.short.5": {
+    "description": "Short name of Friday",
+    "other": "五"
+  },
+  "weekday.short.6": {
+    "description": "Short name of Saturday",
+    "other": "六"
+  },
+  "relative.now": {
+    "description": "A moment that is less than a minute away from now",
+    "other": "現在"
+  },
+  "relative.past.minute": {
+    "description": "A minute or more in the past, e.g. 3 minutes ago",
+    "other": "{{.Count}}分鐘前"
+  },
+  "relative.past.hour": {
+    "description": "A hour or more in the past, e.g. 3 hours ago",
+    "other": "{{.Count}}小時前"
+  },
+  "relative.past.day": {
+    "description": "A day or more in the past, e.g. 3 days ago",
+    "other": "{{.Count}}天前"
+  },
+  "relative.past.week": {
+    "description": "A week or more in the past, e.g. 3 weeks ago",
+    "other": "{{.Count}}週前"
+  },
+  "relative.past.month": {
+    "description": "A month or more in the past, e.g. 3 months ago",
+    "other": "{{.Count}}個月前"
+  },
+  "relative.past.year": {
+    "description": "A year or more in the past, e.g. 3 years ago",
+    "other": "{{.Count}}年前"
+  },
+  "relative.future.minute": {
+    "description": "A minute or more in the future, e.g. in 3 minutes",
+    "other": "{{.Count}}分鐘後"
+  },
+  "relative.future.hour": {
+    "description": "A hour or more in the future, e.g. in 3 hours",
+    "other": "{{.Count}}小時後"
+  },
+  "relative.future.day": {
+    "description": "A day or more in the future, e.g. in 3 days",
+    "other": "{{.Count}}天後"
+  },
+  "relative.future.week": {
+    "description": "A week or more in the future, e.g. in 3 weeks",
+    "other": "{{.Count}}週後"
+  },
+  "relative.future.month": {
+    "description": "A month or more in the future, e.g. in 3 months",
+    "other": "{{.Count}}個月後"
+  },
+  "relative.future.year": {
+    "description": "A year or more in the future, e.g. in 3 years",
+    "other": "{{.Count}}年後"
+  },
+  "duration.day": {
+    "description": "A duration of whole days, e.g. 3 days",
+    "other": "{{.Count}}天"
+  },
+  "duration.hour": {
+    "description": "A duration of whole hours, e.g. 3 hours",
+    "other": "{{.Count}}小時"
+  },
+  "duration.minute": {
+    "description": "A duration of whole minutes, e.g. 3 minutes",
+    "other": "{{.Count}}分鐘"
+  },
+  "duration.second": {
+    "description": "A duration of whole seconds, e.g. 3 seconds",
+    "other": "{{.Count}}秒"
+  },
+  "duration.pair": {
+    "description": "A duration made of two units, e.g. 1 hour 30 minutes",
+    "other": "{{.First}}{{.Second}}"
+  },
+  "list.middle": {
+    "description": "Joins two items of a list that are followed by more items, e.g. Jan 1, Jan 5",
+    "other": "{{.First}}、{{.Second}}"
+  },
+  "list.end": {
+    "description": "Joins the last item of a list to the items before it, e.g. Jan 1, Jan 5 and Jan 10",
+    "other": "{{.First}}和{{.Second}}"
+  },
+  "date.comma": {
+    "description": "Comma between the parts of a date, e.g. before the year in Jan 1, 2023",
+    "other": ", "
+  },
+  "accessible.range": {
+    "description": "A range read out in full by screen readers, e.g. January 1 to January 12, 2023",
+    "other": "{{.From}}至{{.To}}"
+  },
+  "accessible.quarter": {
+    "description": "A quarter read out in full by screen readers, e.g. Quarter 1, 2023",
+    "other": "{{.Year}}年第{{.Quarter}}季"
+  },
+  "accessible.date": {
+    "description": "A day with the full name of its month and, if given, its year, read out in full by screen readers, e.g. January 1, 2023",
+    "other": "{{if .Year}}{{.Year}}年{{end}}{{.Month}}{{.Day}}日"
+  },
+  "accessible.year": {
+    "description": "A year read out in full by screen readers, e.g. 2023",
+    "other": "{{.Year}}年"
+  },
+  "accessible.month": {
+    "description": "The full name of a month and, if given, its year, read out in full by screen readers, e.g. January 2023",
+    "other": "{{if .Year}}{{.Year}}年{{end}}{{.Month}}"
+  },
+  "accessible.weekday": {
+    "description": "A day with the full name of its weekday, given the full date as Date, read out in full by screen readers, e.g. Sunday, January 1, 2023",
+    "other": "{{.Date}}{{.Weekday}}"
+  },
+  "accessible.am": {
+    "description": "A time before noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 9:05 AM",
+    "other": "上午{{.Hour}}點{{if .Minute}}{{.Minute}}分{{end}}"
+  },
+  "accessible.pm": {
+    "description": "A time after noon read out in full by screen readers, given as Time or as Hour and Minute, e.g. 12 PM",
+    "other": "下午{{.Hour}}點{{if .Minute}}{{.Minute}}分{{end}}"
+  },
+  "recurrence.daily": {
+    "description": "A rule repeating every day",
+    "other": "每天"
+  },
+  "recurrence.daily.interval": {
+    "description": "A rule repeating every few days",
+    "other": "每{{.Count}}天"
+  },
+  "recurrence.weekly": {
+    "description": "A rule repeating every week",
+    "other": "每週"
+  },
+  "recurrence.weekly.interval": {
+    "description": "A rule repeating every few weeks",
+    "other": "每{{.Count}}週"
+  },
+  "recurrence.monthly": {
+    "description": "A rule repeating every month",
+    "other": "每月"
+  },
+  "recurrence.monthly.interval": {
+    "description": "A rule repeating every few months",
+    "other": "每{{.Count}}個月"
+  },
+  "recurrence.yearly": {
+    "description": "A rule repeating every year",
+    "other": "每年"
+  },
+  "recurrence.yearly.interval": {
+    "description": "A rule repeating every few years",
+    "other": "每{{.Count}}年"
+  },
+  "recurrence.weekly.days": {
+    "description": "A rule repeating on weekdays every week, e.g. Every Monday and Wednesday",
+    "other": "每{{.Days}}"
+  },
+  "recurrence.weekly.interval.days": {
+    "description": "A rule repeating on weekdays every few weeks, e.g. Every 2 weeks on Monday",
+    "other": "每{{.Count}}週的{{.Days}}"
+  },
+  "recurrence.on": {
+    "description": "A frequency followed by the days it repeats on, e.g. Monthly on the 2nd Tuesday",
+    "other": "{{.Frequency}}{{.Days}}"
+  },
+  "recurrence.monthday": {
+    "description": "A day of the month a rule repeats on, e.g. day 15",
+    "other": "{{.Day}}日"
+  },
+  "recurrence.lastday": {
+    "description": "The last day of the month",
+    "other": "最後一天"
+  },
+  "recurrence.nthweekday": {
+    "description": "A numbered weekday of the month, e.g. the 2nd Tuesday",
+    "other": "{{.Nth}}{{.Weekday}}"
+  },
+  "recurrence.nth.1": {
+    "description": "First, as in the first Monday of the month",
+    "other": "第1個"
+  },
+  "recurrence.nth.2": {
+    "description": "Second, as in the second Monday of the month",
+    "other": "第2個"
+  },
+  "recurrence.nth.3": {
+    "description": "Third, as in the third Monday of the month",
+    "other": "第3個"
+  },
+  "recurrence.nth.4": {
+    "description": "Fourth, as in the fourth Monday of the month",
+    "other": "第4個"
+  },
+  "recurrence.nth.5": {
+    "description": "Fifth, as in the fifth Monday of the month",
+    "other": "第5個"
+  },
+  "recurrence.nth.last": {
+    "description": "Last, as in the last Monday of the month",
+    "other": "最後一個"
+  },
+  "recurrence.once": {
+    "description": "A rule that occurs a single time",
+    "other": "1次"
+  },
+  "recurrence.times": {
+    "description": "The number of times a rule occurs",
+    "other": "{{.Count}}次"
+  },
+  "calendar.islamic.month.long.1": {
+    "description": "Full name of the Islamic month of Muharram",
+    "other": "穆哈蘭姆月"
+  },
+  "calendar.islamic.month.long.2": {
+    "description": "Full name of the Islamic month of Safar",
+    "other": "色法爾月"
+  },
+  "calendar.islamic.month.long.3": {
+    "description": "Full name of the Islamic month of Rabiʻ I",
+    "other": "賴比月 I"
+  },
+  "calendar.islamic.month.long.4": {
+    "description": "Full name of the Islamic month of Rabiʻ II",
+    "other": "賴比月 II"
+  },
+  "calendar.islamic.month.long.5": {
+    "description": "Full name of the Islamic month of Jumada I",
+    "other": "主馬達月 I"
+  },
+  "calendar.islamic.month.long.6": {
+    "description": "Full name of the Islamic month of Jumada II",
+    "other": "主馬達月 II"
+  },
+  "calendar.islamic.month.long.7": {
+    "description": "Full name of the Islamic month of Rajab",
+    "other": "賴哲卜月"
+  },
+  "calendar.islamic.month.long.8": {
+    "description": "Full name of the Islamic month of Shaʻban",
+    "other": "舍爾邦月"
+  },
+  "calendar.islamic.month.long.9": {
+    "description": "Full name of the Islamic month of Ramadan",
+    "other": "賴買丹月"
+  },
+  "calendar.islamic.month.long.10": {
+    "description": "Full name of the Islamic month of Shawwal",
+    "other": "閃瓦魯月"
+  },
+  "calendar.islamic.month.long.11": {
+    "description": "Full name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "都爾喀爾德月"
+  },
+  "calendar.islamic.month.long.12": {
+    "description": "Full name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "都爾黑哲月"
+  },
+  "calendar.islamic.month.short.1": {
+    "description": "Short name of the Islamic month of Muharram",
+    "other": "穆哈蘭姆月"
+  },
+  "calendar.islamic.month.short.2": {
+    "description": "Short name of the Islamic month of Safar",
+    "other": "色法爾月"
+  },
+  "calendar.islamic.month.short.3": {
+    "description": "Short name of the Islamic month of Rabiʻ I",
+    "other": "賴比月 I"
+  },
+  "calendar.islamic.month.short.4": {
+    "description": "Short name of the Islamic month of Rabiʻ II",
+    "other": "賴比月 II"
+  },
+  "calendar.islamic.month.short.5": {
+    "description": "Short name of the Islamic month of Jumada I",
+    "other": "主馬達月 I"
+  },
+  "calendar.islamic.month.short.6": {
+    "description": "Short name of the Islamic month of Jumada II",
+    "other": "主馬達月 II"
+  },
+  "calendar.islamic.month.short.7": {
+    "description": "Short name of the Islamic month of Rajab",
+    "other": "賴哲卜月"
+  },
+  "calendar.islamic.month.short.8": {
+    "description": "Short name of the Islamic month of Shaʻban",
+    "other": "舍爾邦月"
+  },
+  "calendar.islamic.month.short.9": {
+    "description": "Short name of the Islamic month of Ramadan",
+    "other": "賴買丹月"
+  },
+  "calendar.islamic.month.short.10": {
+    "description": "Short name of the Islamic month of Shawwal",
+    "other": "閃瓦魯月"
+  },
+  "calendar.islamic.month.short.11": {
+    "description": "Short name of the Islamic month of Dhuʻl-Qiʻdah",
+    "other": "都爾喀爾德月"
+  },
+  "calendar.islamic.month.short.12": {
+    "description": "Short name of the Islamic month of Dhuʻl-Hijjah",
+    "other": "都爾黑哲月"
+  },
+  "calendar.persian.month.long.1": {
+    "description": "Full name of the Persian month of Farvardin",
+    "other": "1月"
+  },
+  "calendar.persian.month.long.2": {
+    "description": "Full name of the Persian month of Ordibehesht",
+    "other": "2月"
+  },
+  "calendar.persian.month.long.3": {
+    "description": "Full name of the Persian month of Khordad",
+    "other": "3月"
+  },
+  "calendar.persian.month.long.4": {
+    "description": "Full name of the Persian month of Tir",
+    "other": "4月"
+  },
+  "calendar.persian.month.long.5": {
+    "description": "Full name of the Persian month of Mordad",
+    "other": "5月"
+  },
+  "calendar.persian.month.long.6": {
+    "description": "Full name of the Persian month of Shahrivar",
+    "other": "6月"
+  },
+  "calendar.persian.month.long.7": {
+    "description": "Full name of the Persian month of Mehr",
+    "other": "7月"
+  },
+  "calendar.persian.month.long.8": {
+    "description": "Full name of the Persian month of Aban",
+    "other": "8月"
+  },
+  "calendar.persian.month.long.9": {
+    "description": "Full name of the Persian month of Azar",
+    "other": "9月"
+  },
+  "calendar.persian.month.long.10": {
+    "description": "Full name of the Persian month of Dey",
+    "other": "10月"
+  },
+  "calendar.persian.month.long.11": {
+    "description": "Full name of the Persian month of Bahman",
+    "other": "11月"
+  },
+  "calendar.persian.month.long.12": {
+    "description": "Full name of the Persian month of Esfand",
+    "other": "12月"
+  },
+  "calendar.persian.month.short.1": {
+    "description": "Short name of the Persian month of Farvardin",
+    "other": "1月"
+  },
+  "calendar.persian.month.short.2": {
+    "description": "Short name of the Persian month of Ordibehesht",
+    "other": "2月"
+  },
+  "calendar.persian.month.short.3": {
+    "description": "Short name of the Persian month of Khordad",
+    "other": "3月"
+  },
+  "calendar.persian.month.short.4": {
+    "description": "Short name of the Persian month of Tir",
+    "other": "4月"
+  },
+  "calendar.persian.month.short.5": {
+    "description": "Short name of the Persian month of Mordad",
+    "other": "5月"
+  },
+  "calendar.persian.month.short.6": {
+    "description": "Short name of the Persian month of Shahrivar",
+    "other": "6月"
+  },
+  "calendar.persian.month.short.7": {
+    "description": "Short name of the Persian month of Mehr",
+    "other": "7月"
+  },
+  "calendar.persian.month.short.8": {
+    "description": "Short name of the Persian month of Aban",
+    "other": "8月"
+  },
+  "calendar.persian.month.short.9": {
+    "description": "Short name of the Persian month of Azar",
+    "other": "9月"
+  },
+  "calendar.persian.month.short.10": {
+    "description": "Short name of the Persian month of Dey",
+    "other": "10月"
+  },
+  "calendar.persian.month.short.11": {
+    "description": "Short name of the Persian month of Bahman",
+    "other": "11月"
+  },
+  "calendar.persian.month.short.12": {
+    "description": "Short name of the Persian month of Esfand",
+    "other": "12月"
+  },
+  "calendar.hebrew.month.long.1": {
+    "description": "Full name of the Hebrew month of Tishrei",
+    "other": "提斯利月"
+  },
+  "calendar.hebrew.month.long.2": {
+    "description": "Full name of the Hebrew month of Heshvan",
+    "other": "瑪西班月"
+  },
+  "calendar.hebrew.month.long.3": {
+    "description": "Full name of the Hebrew month of Kislev",
+    "other": "基斯流月"
+  },
+  "calendar.hebrew.month.long.4": {
+    "description": "Full name of the Hebrew month of Tevet",
+    "other": "提別月"
+  },
+  "calendar.hebrew.month.long.5": {
+    "description": "Full name of the Hebrew month of Shevat",
+    "other": "細罷特月"
+  },
+  "calendar.hebrew.month.long.6": {
+    "description": "Full name of the Hebrew month of Adar I, in leap years",
+    "other": "亞達月 I"
+  },
+  "calendar.hebrew.month.long.7": {
+    "description": "Full name of the Hebrew month of Adar, in common years",
+    "other": "亞達月"
+  },
+  "calendar.hebrew.month.long.7.leap": {
+    "description": "Full name of the Hebrew month of Adar II, in leap years",
+    "other": "亞達月 II"
+  },
+  "calendar.hebrew.month.long.8": {
+    "description": "Full name of the Hebrew month of Nisan",
+    "other": "尼散月"
+  },
+  "calendar.hebrew.month.long.9": {
+    "description": "Full name of the Hebrew month of Iyar",
+    "other": "以珥月"
+  },
+  "calendar.hebrew.month.long.10": {
+    "description": "Full name of the Hebrew month of Sivan",
+    "other": "西彎月"
+  },
+  "calendar.hebrew.month.long.11": {
+    "description": "Full name of the Hebrew month of Tamuz",
+    "other": "搭模斯月"
+  },
+  "calendar.hebrew.month.long.12": {
+    "description": "Full name of the Hebrew month of Av",
+    "other": "埃波月"
+  },
+  "calendar.hebrew.month.long.13": {
+    "description": "Full name of the Hebrew month of Elul",
+    "other": "以祿月"
+  },
+  "calendar.hebrew.month.short.1": {
+    "description": "Short name of the Hebrew month of Tishrei",
+    "other": "提斯利月"
+  },
+  "calendar.hebrew.month.short.2": {
+    "description": "Short name of the Hebrew month of Heshvan",
+    "other": "瑪西班月"
+  },
+  "calendar.hebrew.month.short.3": {
+    "description": "Short name of the Hebrew month of Kislev",
+    "other": "基斯流月"
+  },
+  "calendar.hebrew.month.short.4": {
+    "description": "Short name of the Hebrew month of Tevet",
+    "other": "提別月"
+  },
+  "calendar.hebrew.month.short.5": {
+    "description": "Short name of the Hebrew month of Shevat",
+    "other": "細罷特月"
+  },
+  "calendar.hebrew.month.short.6": {
+    "description": "Short name of the Hebrew month of Adar I, in leap years",
+    "other": "亞達月 I"
+  },
+  "calendar.hebrew.month.short.7": {
+    "description": "Short name of the Hebrew month of Adar, in common years",
+    "other": "亞達月"
+  },
+  "calendar.hebrew.month.short.7.leap": {
+    "description": "Short name of the Hebrew month of Adar II, in leap years",
+    "other": "亞達月 II"
+  },
+  "calendar.hebrew.month.short.8": {
+    "description": "Short name of the Hebrew month of Nisan",
+    "other": "尼散月"
+  },
+  "calendar.hebrew.month.short.9": {
+    "description": "Short name of the Hebrew month of Iyar",
+    "other": "以珥月"
+  },
+  "calendar.hebrew.month.short.10": {
+    "description": "Short name of the Hebrew month of Sivan",
+    "other": "西彎月"
+  },
+  "calendar.hebrew.month.short.11": {
+    "description": "Short name of the Hebrew month of Tamuz",
+    "other": "搭模斯月"
+  },
+  "calendar.hebrew.month.short.12": {
+    "description": "Short name of the Hebrew month of Av",
+    "other": "埃波月"
+  },
+  "calendar.hebrew.month.short.13": {
+    "description": "Short name of the Hebrew month of Elul",
+    "other": "以祿月"
+  },
+  "calendar.chinese.month.long.1": {
+    "description": "Full name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "正月"
+  },
+  "calendar.chinese.month.long.2": {
+    "description": "Full name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "二月"
+  },
+  "calendar.chinese.month.long.3": {
+    "description": "Full name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "三月"
+  },
+  "calendar.chinese.month.long.4": {
+    "description": "Full name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "四月"
+  },
+  "calendar.chinese.month.long.5": {
+    "description": "Full name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "五月"
+  },
+  "calendar.chinese.month.long.6": {
+    "description": "Full name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "六月"
+  },
+  "calendar.chinese.month.long.7": {
+    "description": "Full name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "七月"
+  },
+  "calendar.chinese.month.long.8": {
+    "description": "Full name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "八月"
+  },
+  "calendar.chinese.month.long.9": {
+    "description": "Full name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "九月"
+  },
+  "calendar.chinese.month.long.10": {
+    "description": "Full name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十月"
+  },
+  "calendar.chinese.month.long.11": {
+    "description": "Full name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "冬月"
+  },
+  "calendar.chinese.month.long.12": {
+    "description": "Full name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "臘月"
+  },
+  "calendar.chinese.month.short.1": {
+    "description": "Short name of the first month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "正月"
+  },
+  "calendar.chinese.month.short.2": {
+    "description": "Short name of the second month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "二月"
+  },
+  "calendar.chinese.month.short.3": {
+    "description": "Short name of the third month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "三月"
+  },
+  "calendar.chinese.month.short.4": {
+    "description": "Short name of the fourth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "四月"
+  },
+  "calendar.chinese.month.short.5": {
+    "description": "Short name of the fifth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "五月"
+  },
+  "calendar.chinese.month.short.6": {
+    "description": "Short name of the sixth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "六月"
+  },
+  "calendar.chinese.month.short.7": {
+    "description": "Short name of the seventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "七月"
+  },
+  "calendar.chinese.month.short.8": {
+    "description": "Short name of the eighth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "八月"
+  },
+  "calendar.chinese.month.short.9": {
+    "description": "Short name of the ninth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "九月"
+  },
+  "calendar.chinese.month.short.10": {
+    "description": "Short name of the tenth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十月"
+  },
+  "calendar.chinese.month.short.11": {
+    "description": "Short name of the eleventh month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "冬月"
+  },
+  "calendar.chinese.month.short.12": {
+    "description": "Short name of the twelfth month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "臘月"
+  },
+  "calendar.chinese.month.leap": {
+    "description": "Name of a leap month of the Chinese and Vietnamese lunisolar calendars, made from the name of the month it repeats",
+    "other": "閏{{.Month}}"
+  },
+  "calendar.chinese.day.1": {
+    "description": "Day 1 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初一"
+  },
+  "calendar.chinese.day.2": {
+    "description": "Day 2 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初二"
+  },
+  "calendar.chinese.day.3": {
+    "description": "Day 3 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初三"
+  },
+  "calendar.chinese.day.4": {
+    "description": "Day 4 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初四"
+  },
+  "calendar.chinese.day.5": {
+    "description": "Day 5 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初五"
+  },
+  "calendar.chinese.day.6": {
+    "description": "Day 6 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初六"
+  },
+  "calendar.chinese.day.7": {
+    "description": "Day 7 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初七"
+  },
+  "calendar.chinese.day.8": {
+    "description": "Day 8 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初八"
+  },
+  "calendar.chinese.day.9": {
+    "description": "Day 9 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初九"
+  },
+  "calendar.chinese.day.10": {
+    "description": "Day 10 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "初十"
+  },
+  "calendar.chinese.day.11": {
+    "description": "Day 11 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十一"
+  },
+  "calendar.chinese.day.12": {
+    "description": "Day 12 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十二"
+  },
+  "calendar.chinese.day.13": {
+    "description": "Day 13 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十三"
+  },
+  "calendar.chinese.day.14": {
+    "description": "Day 14 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十四"
+  },
+  "calendar.chinese.day.15": {
+    "description": "Day 15 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十五"
+  },
+  "calendar.chinese.day.16": {
+    "description": "Day 16 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十六"
+  },
+  "calendar.chinese.day.17": {
+    "description": "Day 17 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十七"
+  },
+  "calendar.chinese.day.18": {
+    "description": "Day 18 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十八"
+  },
+  "calendar.chinese.day.19": {
+    "description": "Day 19 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "十九"
+  },
+  "calendar.chinese.day.20": {
+    "description": "Day 20 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "二十"
+  },
+  "calendar.chinese.day.21": {
+    "description": "Day 21 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿一"
+  },
+  "calendar.chinese.day.22": {
+    "description": "Day 22 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿二"
+  },
+  "calendar.chinese.day.23": {
+    "description": "Day 23 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿三"
+  },
+  "calendar.chinese.day.24": {
+    "description": "Day 24 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿四"
+  },
+  "calendar.chinese.day.25": {
+    "description": "Day 25 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿五"
+  },
+  "calendar.chinese.day.26": {
+    "description": "Day 26 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿六"
+  },
+  "calendar.chinese.day.27": {
+    "description": "Day 27 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿七"
+  },
+  "calendar.chinese.day.28": {
+    "description": "Day 28 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿八"
+  },
+  "calendar.chinese.day.29": {
+    "description": "Day 29 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "廿九"
+  },
+  "calendar.chinese.day.30": {
+    "description": "Day 30 of a month of the Chinese and Vietnamese lunisolar calendars",
+    "other": "三十"
+  },
+  "calendar.chinese.day.end": {
+    "description": "The last day of a range of days of a month of the Chinese and Vietnamese lunisolar calendars, given as its number (Day) or its name (Name), e.g. \"5\" in \"Mùng 1 - 5 Tết\"",
+    "other": "{{.Name}}"
+  },
+  "calendar.chinese.date": {
+    "description": "A day or range of days of a month of the Chinese and Vietnamese lunisolar calendars, e.g. \"Mo1 1 - 5\"",
+    "other": "{{.Month}}{{.Day}}"
+  }
+}`,
+}
@@ -0,0 +1,190 @@
+package littledate
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// maxCachedLocales bounds the number of distinct locale strings remembered
+// by the lookup cache, so that arbitrary user input cannot grow it forever
+const maxCachedLocales = 256
+
+// localeNames holds the precomputed month and weekday names of one locale.
+// Tables are built once and never modified afterwards.
+type localeNames struct {
+	months        [12]string
+	shortMonths   [12]string
+	weekdays      [7]string
+	shortWeekdays [7]string
+
+	// comma separates the parts of a date, e.g. the year in "Jan 1, 2023"
+	comma string
+}
+
+// localeData is everything the formatter needs to know about a requested locale
+type localeData struct {
+	names  *localeNames
+	hour24 bool
+
+	// rtl is set for locales written from right to left, which put the day
+	// before the month, e.g. "١ - ١٢ يناير"
+	rtl bool
+
+	// yearFirst is set for locales writing the year before the day, which
+	// give a range of days in one year its year on the start, e.g.
+	// "2023年1月1日から1月12日まで"
+	yearFirst bool
+
+	// digits is the numbering system of the locale, used unless the options set one
+	digits NumberingSystem
+
+	// localizer looks up the phrases that are not precomputed, such as relative times
+	localizer *i18n.Localizer
+
+	// variants holds copies of the data with other numbering systems, see withDigits
+	variants *digitVariants
+}
+
+// digitVariants caches the copies of a localeData made by withDigits. Like
+// the locale cache, the map is replaced, never modified.
+type digitVariants struct {
+	mu      sync.Mutex
+	systems atomic.Pointer[map[NumberingSystem]*localeData]
+}
+
+// withDigits returns the data with numbers written in another numbering
+// system, as set by DateRangeFormatOptions.NumberingSystem. Unknown systems
+// are written with Latin digits. Copies are cached, so repeated calls do
+// not allocate.
+func (d *localeData) withDigits(system NumberingSystem) *localeData {
+	if system == "" {
+		return d
+	}
+	if digitsOf(system) == nil {
+		system = LatinDigits
+	}
+	if system == d.digits {
+		return d
+	}
+	if systems := d.variants.systems.Load(); systems != nil {
+		if variant, ok := (*systems)[system]; ok {
+			return variant
+		}
+	}
+
+	d.variants.mu.Lock()
+	defer d.variants.mu.Unlock()
+
+	var cached map[NumberingSystem]*localeData
+	if systems := d.variants.systems.Load(); systems != nil {
+		cached = *systems
+	}
+	if variant, ok := cached[system]; ok {
+		return variant
+	}
+	variant := *d
+	variant.digits = system
+	updated := make(map[NumberingSystem]*localeData, len(cached)+1)
+	for key, value := range cached {
+		updated[key] = value
+	}
+	updated[system] = &variant
+	d.variants.systems.Store(&updated)
+	return &variant
+}
+
+// buildLocaleNames looks up all month and weekday names through a localizer
+func buildLocaleNames(localizer *i18n.Localizer) *localeNames {
+	names := &localeNames{}
+	for i := range names.months {
+		month := time.Month(i + 1)
+		names.months[i] = localizeName(localizer, "month.long."+strconv.Itoa(i+1), month.String())
+		names.shortMonths[i] = localizeName(localizer, "month.short."+strconv.Itoa(i+1), month.String()[:3])
+	}
+	for i := range names.weekdays {
+		weekday := time.Weekday(i)
+		names.weekdays[i] = localizeName(localizer, "weekday.long."+strconv.Itoa(i), weekday.String())
+		names.shortWeekdays[i] = localizeName(localizer, "weekday.short."+strconv.Itoa(i), weekday.String()[:3])
+	}
+	names.comma = localizeName(localizer, "date.comma", ", ")
+	return names
+}
+
+// localizeName looks up a single message, using the English name if the translation is missing
+func localizeName(localizer *i18n.Localizer, messageID, fallback string) string {
+	localized, err := localizer.Localize(&i18n.LocalizeConfig{
+		MessageID: messageID,
+	})
+	if err != nil {
+		return fallback
+	}
+	return localized
+}
+
+// localize looks up a phrase, using count to choose the plural form
+func (d *localeData) localize(messageID string, count int, data map[string]interface{}) string {
+	config := &i18n.LocalizeConfig{
+		MessageID:    messageID,
+		TemplateData: data,
+	}
+	if count >= 0 {
+		config.PluralCount = count
+	}
+
+	// A message missing in the locale and in English is returned as its ID
+	localized, err := d.localizer.Localize(config)
+	if localized == "" && err != nil {
+		return messageID
+	}
+	return localized
+}
+
+// lookupLocale returns the formatting data for a locale string.
+// Repeated lookups of the same string are served from a cache and do not allocate.
+func lookupLocale(locale string) *localeData {
+	return currentSnapshot().lookup(locale)
+}
+
+// localeOf returns the formatting data for the locale and numbering system of the options
+func localeOf(options DateRangeFormatOptions) *localeData {
+	return lookupLocale(options.Locale).withDigits(options.NumberingSystem)
+}
+
+// lookup returns the formatting data for a locale string from the snapshot's cache
+func (s *snapshot) lookup(locale string) *localeData {
+	if data, ok := (*s.cache.Load())[locale]; ok {
+		return data
+	}
+
+	resolved := s.match(locale).Locale
+	data := &localeData{
+		names:     s.tables[resolved],
+		hour24:    is24Hour(locale),
+		rtl:       isRightToLeft(language.MustParse(resolved)),
+		yearFirst: isYearFirst(language.MustParse(resolved)),
+		digits:    localeNumberingSystem(locale),
+		localizer: i18n.NewLocalizer(s.bundle, fallbackChain(language.MustParse(resolved))...),
+		variants:  &digitVariants{},
+	}
+
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+
+	cache := *s.cache.Load()
+	if len(cache) >= maxCachedLocales {
+		return data
+	}
+	updated := make(map[string]*localeData, len(cache)+1)
+	for key, value := range cache {
+		updated[key] = value
+	}
+	updated[locale] = data
+	s.cache.Store(&updated)
+
+	return data
+}
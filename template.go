@@ -0,0 +1,202 @@
+package littledate
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"strconv"
+	"text/template"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// FuncMap returns functions for text/template that format with the given options:
+//
+//	{{ dateRange .Start .End }}  Jan 1 - 12
+//	{{ date .Start }}            Sun, Jan 1
+//	{{ relative .Start }}        3 days ago
+//	{{ duration .Elapsed }}      1 hour 30 minutes
+//
+// Every function accepts optional trailing arguments taken from the template
+// data, which override the options: a locale string, a *time.Location or
+// the name of a time zone such as "Europe/Paris", or a Formatter or
+// DateRangeFormatOptions replacing all options:
+//
+//	{{ dateRange .Start .End .User.Locale .User.Location }}
+//	{{ date .Start .Formatter }}
+func FuncMap(options DateRangeFormatOptions) template.FuncMap {
+	return template.FuncMap{
+		"dateRange": func(from, to time.Time, args ...interface{}) (string, error) {
+			opts, err := templateOptions(options, args)
+			if err != nil {
+				return "", err
+			}
+			return FormatDateRange(from, to, opts), nil
+		},
+		"date": func(date time.Time, args ...interface{}) (string, error) {
+			opts, err := templateOptions(options, args)
+			if err != nil {
+				return "", err
+			}
+			return FormatDate(date, opts), nil
+		},
+		"relative": func(date time.Time, args ...interface{}) (string, error) {
+			opts, err := templateOptions(options, args)
+			if err != nil {
+				return "", err
+			}
+			return FormatRelative(date, opts), nil
+		},
+		"duration": func(d time.Duration, args ...interface{}) (string, error) {
+			opts, err := templateOptions(options, args)
+			if err != nil {
+				return "", err
+			}
+			return FormatDuration(d, opts), nil
+		},
+	}
+}
+
+// HTMLFuncMap returns the functions of FuncMap for html/template. They emit
+// <time> elements with a machine-readable datetime attribute:
+//
+//	{{ date .Start }}            <time datetime="2023-01-01T00:00:00Z">Sun, Jan 1</time>
+//	{{ duration .Elapsed }}      <time datetime="PT1H30M">1 hour 30 minutes</time>
+//
+// A range has the start in its datetime attribute and the end in a data-end attribute:
+//
+//	{{ dateRange .Start .End }}  <time datetime="2023-01-01T00:00:00Z" data-end="2023-01-12T23:59:59Z">Jan 1 - 12</time>
+func HTMLFuncMap(options DateRangeFormatOptions) htmltemplate.FuncMap {
+	return htmltemplate.FuncMap{
+		"dateRange": func(from, to time.Time, args ...interface{}) (htmltemplate.HTML, error) {
+			opts, err := templateOptions(options, args)
+			if err != nil {
+				return "", err
+			}
+			return timeElement(FormatDateRange(from, to, opts), htmlDateTime(from, opts), htmlDateTime(to, opts)), nil
+		},
+		"date": func(date time.Time, args ...interface{}) (htmltemplate.HTML, error) {
+			opts, err := templateOptions(options, args)
+			if err != nil {
+				return "", err
+			}
+			return timeElement(FormatDate(date, opts), htmlDateTime(date, opts), ""), nil
+		},
+		"relative": func(date time.Time, args ...interface{}) (htmltemplate.HTML, error) {
+			opts, err := templateOptions(options, args)
+			if err != nil {
+				return "", err
+			}
+			return timeElement(FormatRelative(date, opts), htmlDateTime(date, opts), ""), nil
+		},
+		"duration": func(d time.Duration, args ...interface{}) (htmltemplate.HTML, error) {
+			opts, err := templateOptions(options, args)
+			if err != nil {
+				return "", err
+			}
+			return timeElement(FormatDuration(d, opts), isoDuration(d), ""), nil
+		},
+	}
+}
+
+// templateOptions applies the optional trailing arguments of a template function to options
+func templateOptions(options DateRangeFormatOptions, args []interface{}) (DateRangeFormatOptions, error) {
+	for _, arg := range args {
+		switch arg := arg.(type) {
+		case string:
+			location, err := templateString(arg)
+			if err != nil {
+				return options, err
+			}
+			if location != nil {
+				options.Location = location
+			} else {
+				options.Locale = arg
+			}
+		case *time.Location:
+			options.Location = arg
+		case Formatter:
+			options = arg.Options
+		case *Formatter:
+			options = arg.Options
+		case DateRangeFormatOptions:
+			options = arg
+		default:
+			return options, fmt.Errorf("littledate: unexpected template argument of type %T", arg)
+		}
+	}
+	return options, nil
+}
+
+// templateString resolves a string argument of a template function. Locales
+// with translations are preferred, then IANA time zones such as
+// "Europe/Paris", which are returned as a location, then other well-formed
+// BCP 47 tags, which fall back like any other locale. Anything else is an
+// error.
+func templateString(arg string) (*time.Location, error) {
+	if MatchLocale(arg).Confidence != language.No {
+		return nil, nil
+	}
+	if location, err := time.LoadLocation(arg); err == nil {
+		return location, nil
+	}
+	if _, err := parseLocale(arg); err == nil {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("littledate: template argument %q is neither a locale nor a time zone", arg)
+}
+
+// htmlDateTime formats t for a datetime attribute
+func htmlDateTime(t time.Time, options DateRangeFormatOptions) string {
+	if options.Location != nil {
+		t = t.In(options.Location)
+	}
+	return t.Format(time.RFC3339)
+}
+
+// timeElement returns a <time> element with escaped content and attributes
+func timeElement(text, datetime, end string) htmltemplate.HTML {
+	html := `<time datetime="` + htmltemplate.HTMLEscapeString(datetime) + `"`
+	if end != "" {
+		html += ` data-end="` + htmltemplate.HTMLEscapeString(end) + `"`
+	}
+	html += `>` + htmltemplate.HTMLEscapeString(text) + `</time>`
+	return htmltemplate.HTML(html)
+}
+
+// isoDuration formats d as an ISO 8601 duration, e.g. "PT1H30M"
+func isoDuration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+
+	b := []byte("P")
+	if days := d / (24 * time.Hour); days > 0 {
+		b = strconv.AppendInt(b, int64(days), 10)
+		b = append(b, 'D')
+		d -= days * 24 * time.Hour
+	}
+	if d == 0 {
+		if len(b) == 1 {
+			return "PT0S"
+		}
+		return string(b)
+	}
+
+	b = append(b, 'T')
+	if hours := d / time.Hour; hours > 0 {
+		b = strconv.AppendInt(b, int64(hours), 10)
+		b = append(b, 'H')
+		d -= hours * time.Hour
+	}
+	if minutes := d / time.Minute; minutes > 0 {
+		b = strconv.AppendInt(b, int64(minutes), 10)
+		b = append(b, 'M')
+		d -= minutes * time.Minute
+	}
+	if d > 0 {
+		b = strconv.AppendFloat(b, d.Seconds(), 'f', -1, 64)
+		b = append(b, 'S')
+	}
+	return string(b)
+}
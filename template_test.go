@@ -0,0 +1,143 @@
+package littledate
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+)
+
+type templateData struct {
+	Start     time.Time
+	End       time.Time
+	Elapsed   time.Duration
+	Locale    string
+	Location  *time.Location
+	Formatter Formatter
+}
+
+func newTemplateData(t *testing.T) templateData {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return templateData{
+		Start:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:       time.Date(2023, 1, 12, 23, 59, 59, 999999999, time.UTC),
+		Elapsed:   90 * time.Minute,
+		Locale:    "de",
+		Location:  tokyo,
+		Formatter: Formatter{Options: DateRangeFormatOptions{Today: today, Locale: "fr"}},
+	}
+}
+
+func TestFuncMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{name: "dateRange", template: `{{ dateRange .Start .End }}`, expected: "Jan 1 - 12"},
+		{name: "date", template: `{{ date .Start }}`, expected: "Sun, Jan 1"},
+		{name: "relative", template: `{{ relative .Start }}`, expected: "10 months ago"},
+		{name: "duration", template: `{{ duration .Elapsed }}`, expected: "1 hour 30 minutes"},
+		{name: "locale from data", template: `{{ duration .Elapsed .Locale }}`, expected: "1 Stunde 30 Minuten"},
+		{name: "time zone from data", template: `{{ date .End .Location }}`, expected: "Jan 13, 8:59am"},
+		{name: "time zone name", template: `{{ date .End "Asia/Tokyo" }}`, expected: "Jan 13, 8:59am"},
+		{name: "locale and time zone names", template: `{{ date .End "fr" "Asia/Tokyo" }}`, expected: "janv. 13, 8:59"},
+		{name: "bound formatter", template: `{{ date .Start .Formatter }}`, expected: "dim., janv. 1"},
+	}
+
+	data := newTemplateData(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := template.Must(template.New("test").Funcs(FuncMap(defaultOptions)).Parse(tt.template))
+			var out strings.Builder
+			if err := tmpl.Execute(&out, data); err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if out.String() != tt.expected {
+				t.Errorf("Execute() = %v, want %v", out.String(), tt.expected)
+			}
+		})
+	}
+
+	for _, text := range []string{`{{ date .Start 42 }}`, `{{ date .Start "Mars/Olympus" }}`} {
+		tmpl := template.Must(template.New("test").Funcs(FuncMap(defaultOptions)).Parse(text))
+		if err := tmpl.Execute(&strings.Builder{}, data); err == nil {
+			t.Errorf("Execute(%s) error = nil, want an error", text)
+		}
+	}
+}
+
+func TestHTMLFuncMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{
+			name:     "dateRange",
+			template: `<p>{{ dateRange .Start .End }}</p>`,
+			expected: `<p><time datetime="2023-01-01T00:00:00Z" data-end="2023-01-12T23:59:59Z">Jan 1 - 12</time></p>`,
+		},
+		{
+			name:     "date in time zone",
+			template: `{{ date .Start .Location }}`,
+			expected: `<time datetime="2023-01-01T09:00:00+09:00">Jan 1, 9am</time>`,
+		},
+		{
+			name:     "duration",
+			template: `{{ duration .Elapsed }}`,
+			expected: `<time datetime="PT1H30M">1 hour 30 minutes</time>`,
+		},
+		{
+			name:     "bound formatter",
+			template: `{{ dateRange .Start .End (.Formatter) }}`,
+			expected: `<time datetime="2023-01-01T00:00:00Z" data-end="2023-01-12T23:59:59Z">janv. 1 - 12</time>`,
+		},
+	}
+
+	data := newTemplateData(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := htmltemplate.Must(htmltemplate.New("test").Funcs(HTMLFuncMap(defaultOptions)).Parse(tt.template))
+			var out strings.Builder
+			if err := tmpl.Execute(&out, data); err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if out.String() != tt.expected {
+				t.Errorf("Execute() = %v, want %v", out.String(), tt.expected)
+			}
+		})
+	}
+
+	options := DateRangeFormatOptions{Today: today, Separator: "<to>"}
+	tmpl := htmltemplate.Must(htmltemplate.New("test").Funcs(HTMLFuncMap(options)).Parse(`{{ dateRange .Start .End }}`))
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !strings.Contains(out.String(), ">Jan 1 &lt;to&gt; 12</time>") {
+		t.Errorf("Execute() = %v, want escaped separator", out.String())
+	}
+}
+
+func TestISODuration(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		expected string
+	}{
+		{0, "PT0S"},
+		{90 * time.Minute, "PT1H30M"},
+		{48 * time.Hour, "P2D"},
+		{26*time.Hour + 1500*time.Millisecond, "P1DT2H1.5S"},
+	}
+
+	for _, tt := range tests {
+		if result := isoDuration(tt.duration); result != tt.expected {
+			t.Errorf("isoDuration(%v) = %v, want %v", tt.duration, result, tt.expected)
+		}
+	}
+}